@@ -13,7 +13,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
@@ -41,6 +40,15 @@ func NewIBCHandler(k types.IBCContractKeeper, ck types.ChannelKeeper, vg appVers
 	return IBCHandler{keeper: k, channelKeeper: ck, appVersionGetter: vg}
 }
 
+// contractByPortID returns the contract that owns the given port
+func (i IBCHandler) contractByPortID(ctx sdk.Context, portID string) (sdk.AccAddress, error) {
+	contractAddr, ok := i.keeper.ContractByPortID(ctx, portID)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "contract for port %s", portID)
+	}
+	return contractAddr, nil
+}
+
 // OnChanOpenInit implements the IBCModule interface
 func (i IBCHandler) OnChanOpenInit(
 	ctx sdk.Context,
@@ -55,7 +63,7 @@ func (i IBCHandler) OnChanOpenInit(
 	if err := ValidateChannelParams(channelID); err != nil {
 		return "", err
 	}
-	contractAddr, err := keeper.ContractFromPortID(portID)
+	contractAddr, err := i.contractByPortID(ctx, portID)
 	if err != nil {
 		return "", errorsmod.Wrapf(err, "contract port id")
 	}
@@ -102,7 +110,7 @@ func (i IBCHandler) OnChanOpenTry(
 		return "", err
 	}
 
-	contractAddr, err := keeper.ContractFromPortID(portID)
+	contractAddr, err := i.contractByPortID(ctx, portID)
 	if err != nil {
 		return "", errorsmod.Wrapf(err, "contract port id")
 	}
@@ -139,7 +147,7 @@ func (i IBCHandler) OnChanOpenAck(
 	counterpartyChannelID string,
 	counterpartyVersion string,
 ) error {
-	contractAddr, err := keeper.ContractFromPortID(portID)
+	contractAddr, err := i.contractByPortID(ctx, portID)
 	if err != nil {
 		return errorsmod.Wrapf(err, "contract port id")
 	}
@@ -165,7 +173,7 @@ func (i IBCHandler) OnChanOpenAck(
 
 // OnChanOpenConfirm implements the IBCModule interface
 func (i IBCHandler) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
-	contractAddr, err := keeper.ContractFromPortID(portID)
+	contractAddr, err := i.contractByPortID(ctx, portID)
 	if err != nil {
 		return errorsmod.Wrapf(err, "contract port id")
 	}
@@ -187,7 +195,7 @@ func (i IBCHandler) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string)
 
 // OnChanCloseInit implements the IBCModule interface
 func (i IBCHandler) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
-	contractAddr, err := keeper.ContractFromPortID(portID)
+	contractAddr, err := i.contractByPortID(ctx, portID)
 	if err != nil {
 		return errorsmod.Wrapf(err, "contract port id")
 	}
@@ -215,7 +223,7 @@ func (i IBCHandler) OnChanCloseInit(ctx sdk.Context, portID, channelID string) e
 // OnChanCloseConfirm implements the IBCModule interface
 func (i IBCHandler) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
 	// counterparty has closed the channel
-	contractAddr, err := keeper.ContractFromPortID(portID)
+	contractAddr, err := i.contractByPortID(ctx, portID)
 	if err != nil {
 		return errorsmod.Wrapf(err, "contract port id")
 	}
@@ -257,7 +265,7 @@ func (i IBCHandler) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	contractAddr, err := keeper.ContractFromPortID(packet.DestinationPort)
+	contractAddr, err := i.contractByPortID(ctx, packet.DestinationPort)
 	if err != nil {
 		// this must not happen as ports were registered before
 		panic(errorsmod.Wrapf(err, "contract port id"))
@@ -286,7 +294,7 @@ func (i IBCHandler) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	contractAddr, err := keeper.ContractFromPortID(packet.SourcePort)
+	contractAddr, err := i.contractByPortID(ctx, packet.SourcePort)
 	if err != nil {
 		return errorsmod.Wrapf(err, "contract port id")
 	}
@@ -304,7 +312,7 @@ func (i IBCHandler) OnAcknowledgementPacket(
 
 // OnTimeoutPacket implements the IBCModule interface
 func (i IBCHandler) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
-	contractAddr, err := keeper.ContractFromPortID(packet.SourcePort)
+	contractAddr, err := i.contractByPortID(ctx, packet.SourcePort)
 	if err != nil {
 		return errorsmod.Wrapf(err, "contract port id")
 	}
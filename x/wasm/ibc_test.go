@@ -1,6 +1,7 @@
 package wasm
 
 import (
+	"context"
 	"testing"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
@@ -103,6 +104,10 @@ func TestOnRecvPacket(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			mock := wasmtesting.IBCContractKeeperMock{
+				ContractByPortIDFn: func(ctx context.Context, portID string) (sdk.AccAddress, bool) {
+					addr, err := keeper.ContractFromPortID(portID)
+					return addr, err == nil
+				},
 				OnRecvPacketFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.IBCPacketReceiveMsg) (ibcexported.Acknowledgement, error) {
 					// additional custom event to confirm event handling on state commit/ rollback
 					ctx.EventManager().EmitEvent(myCustomEvent)
@@ -32,6 +32,16 @@ func PredictableAddressGenerator(creator sdk.AccAddress, salt, msg []byte, fixMs
 	}
 }
 
+// PredictInstantiate2Address returns the address that an instantiate2 call with the given arguments would create
+// for a contract of the given code. The state is not modified.
+func (k Keeper) PredictInstantiate2Address(ctx context.Context, codeID uint64, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) (sdk.AccAddress, error) {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return nil, types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	return PredictableAddressGenerator(creator, salt, initMsg, fixMsg)(ctx, codeID, codeInfo.CodeHash), nil
+}
+
 // BuildContractAddressClassic builds an address for a contract.
 func BuildContractAddressClassic(codeID, instanceID uint64) sdk.AccAddress {
 	contractID := make([]byte, 16)
@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -101,3 +104,25 @@ func BenchmarkCompilation(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkConvertWasmCoinsToSdkCoins compares the coin conversion with the former coin by coin addition
+func BenchmarkConvertWasmCoinsToSdkCoins(b *testing.B) {
+	specs := map[string]struct {
+		convert func([]wasmvmtypes.Coin) (sdk.Coins, error)
+	}{
+		"legacy":      {convert: legacyConvertWasmCoinsToSdkCoins},
+		"single sort": {convert: ConvertWasmCoinsToSdkCoins},
+	}
+	for _, n := range []int{10, 100, 1000} {
+		coins := benchmarkWasmCoins(n, n)
+		for name, spec := range specs {
+			b.Run(fmt.Sprintf("%s-%d", name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if _, err := spec.convert(coins); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -172,6 +172,36 @@ func TestInstantiate2(t *testing.T) {
 	}
 }
 
+func TestPredictInstantiate2Address(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	parentCtx = parentCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	mock := &wasmtesting.MockWasmEngine{}
+	wasmtesting.MakeInstantiable(mock)
+	keepers.WasmKeeper.wasmVM = mock // set mock to not fail on contract init message
+
+	initMsg := mustMarshal(t, HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)})
+	mySalt := []byte("my salt")
+
+	for _, fixMsg := range []bool{true, false} {
+		t.Run(fmt.Sprintf("fix msg: %v", fixMsg), func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			// when
+			gotAddr, gotErr := keepers.WasmKeeper.PredictInstantiate2Address(ctx, example.CodeID, example.CreatorAddr, mySalt, initMsg, fixMsg)
+
+			// then
+			require.NoError(t, gotErr)
+			assert.False(t, keepers.WasmKeeper.HasContractInfo(ctx, gotAddr))
+			contractAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "my label", nil, mySalt, fixMsg)
+			require.NoError(t, err)
+			assert.Equal(t, contractAddr, gotAddr)
+		})
+	}
+	_, gotErr := keepers.WasmKeeper.PredictInstantiate2Address(parentCtx, 999, example.CreatorAddr, mySalt, initMsg, false)
+	assert.ErrorIs(t, gotErr, types.ErrNoSuchCodeFn(999))
+}
+
 func TestQuerierError(t *testing.T) {
 	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	parentCtx = parentCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
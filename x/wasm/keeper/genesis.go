@@ -41,6 +41,9 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 				return nil, errorsmod.Wrapf(err, "contract number %d", i)
 			}
 		}
+		if err := keeper.importCodeSettings(ctx, code); err != nil {
+			return nil, errorsmod.Wrapf(err, "code %d with id: %d", i, code.CodeID)
+		}
 	}
 
 	for i, contract := range data.Contracts {
@@ -52,6 +55,9 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "contract number %d", i)
 		}
+		if err := keeper.importContractFlags(ctx, contractAddr, contract); err != nil {
+			return nil, errorsmod.Wrapf(err, "contract number %d", i)
+		}
 	}
 
 	for i, seq := range data.Sequences {
@@ -61,6 +67,12 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		}
 	}
 
+	if data.UploadFrozen {
+		if err := keeper.SetUploadFrozen(ctx, keeper.GetAuthority(), true); err != nil {
+			return nil, errorsmod.Wrap(err, "upload frozen")
+		}
+	}
+
 	// sanity check seq values
 	seqVal, err := keeper.PeekAutoIncrementID(ctx, types.KeySequenceCodeID)
 	if err != nil {
@@ -94,10 +106,13 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			panic(err)
 		}
 		genState.Codes = append(genState.Codes, types.Code{
-			CodeID:    codeID,
-			CodeInfo:  info,
-			CodeBytes: bytecode,
-			Pinned:    keeper.IsPinnedCode(ctx, codeID),
+			CodeID:        codeID,
+			CodeInfo:      info,
+			CodeBytes:     bytecode,
+			Pinned:        keeper.IsPinnedCode(ctx, codeID),
+			GasLimit:      keeper.GetCodeGasLimit(ctx, codeID),
+			SudoAllowList: keeper.GetSudoAllowList(ctx, codeID),
+			Metadata:      keeper.GetCodeMetadata(ctx, codeID),
 		})
 		return false
 	})
@@ -116,10 +131,14 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			ContractInfo:        contract,
 			ContractState:       state,
 			ContractCodeHistory: contractCodeHistory,
+			Paused:              keeper.IsContractPaused(ctx, addr),
+			StateVersion:        keeper.GetContractStateVersion(ctx, addr),
 		})
 		return false
 	})
 
+	genState.UploadFrozen = keeper.IsUploadFrozen(ctx)
+
 	for _, k := range [][]byte{types.KeySequenceCodeID, types.KeySequenceInstanceID} {
 		id, err := keeper.PeekAutoIncrementID(ctx, k)
 		if err != nil {
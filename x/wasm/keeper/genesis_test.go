@@ -56,6 +56,11 @@ func TestGenesisExportImport(t *testing.T) {
 			history           []types.ContractCodeHistoryEntry
 			pinned            bool
 			contractExtension bool
+			gasLimit          uint64
+			restrictSudo      bool
+			metadata          []byte
+			paused            bool
+			stateVersion      uint64
 		)
 		f.Fuzz(&codeInfo)
 		f.Fuzz(&contract)
@@ -63,6 +68,11 @@ func TestGenesisExportImport(t *testing.T) {
 		f.NilChance(0).Fuzz(&history)
 		f.Fuzz(&pinned)
 		f.Fuzz(&contractExtension)
+		f.Fuzz(&gasLimit)
+		f.Fuzz(&restrictSudo)
+		f.Fuzz(&metadata)
+		f.Fuzz(&paused)
+		f.Fuzz(&stateVersion)
 
 		creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
 		require.NoError(t, err)
@@ -72,6 +82,11 @@ func TestGenesisExportImport(t *testing.T) {
 			err = contractKeeper.PinCode(srcCtx, codeID)
 			require.NoError(t, err)
 		}
+		require.NoError(t, wasmKeeper.SetCodeGasLimit(srcCtx, wasmKeeper.GetAuthority(), codeID, gasLimit))
+		if restrictSudo {
+			require.NoError(t, wasmKeeper.SetSudoAllowList(srcCtx, wasmKeeper.GetAuthority(), codeID, []string{"foo", "bar"}))
+		}
+		require.NoError(t, wasmKeeper.SetCodeMetadata(srcCtx, creatorAddr, codeID, metadata))
 		if contractExtension {
 			anyTime := time.Now().UTC()
 			var nestedType v1beta1.TextProposal
@@ -88,7 +103,12 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...))
 		err = wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
 		require.NoError(t, err)
+		require.NoError(t, wasmKeeper.SetContractPaused(srcCtx, wasmKeeper.GetAuthority(), contractAddr, paused))
+		if stateVersion != 0 {
+			require.NoError(t, wasmKeeper.SetContractStateVersion(srcCtx, contractAddr, stateVersion))
+		}
 	}
+	require.NoError(t, wasmKeeper.SetUploadFrozen(srcCtx, wasmKeeper.GetAuthority(), true))
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
 	err = wasmKeeper.SetParams(srcCtx, wasmParams)
@@ -121,6 +141,14 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, err)
 		err = wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, creatorAddress, history[0].Updated, address)
 		require.NoError(t, err)
+		err = wasmKeeper.addToContractHeightSecondaryIndex(srcCtx, history[0].Updated, address)
+		require.NoError(t, err)
+		if adminAddress := info.AdminAddr(); adminAddress != nil {
+			err = wasmKeeper.addToContractAdminSecondaryIndex(srcCtx, adminAddress, address)
+			require.NoError(t, err)
+		}
+		err = wasmKeeper.addToCount(srcCtx, types.KeyContractCount, 1)
+		require.NoError(t, err)
 		return false
 	})
 
@@ -133,6 +161,8 @@ func TestGenesisExportImport(t *testing.T) {
 	require.NoError(t, err)
 	_, err = InitGenesis(dstCtx, dstKeeper, importState)
 	require.NoError(t, err)
+	assert.Equal(t, uint64(25), dstKeeper.CodeCount(dstCtx))
+	assert.Equal(t, uint64(25), dstKeeper.ContractCount(dstCtx))
 
 	// compare whole DB
 
@@ -505,6 +535,125 @@ func TestGenesisInit(t *testing.T) {
 	}
 }
 
+func TestExportContract(t *testing.T) {
+	srcCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	srcKeeper := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, srcCtx, keepers)
+
+	// when
+	snapshot, err := srcKeeper.ExportContract(srcCtx, example.Contract)
+
+	// then
+	require.NoError(t, err)
+	require.NoError(t, snapshot.ValidateBasic())
+	assert.Equal(t, example.CodeID, snapshot.Code.CodeID)
+	assert.Equal(t, example.Contract.String(), snapshot.Contract.ContractAddress)
+	assert.NotEmpty(t, snapshot.Contract.ContractState)
+
+	// and re-import on a fresh chain
+	dstKeeper, dstCtx := setupKeeper(t)
+	require.NoError(t, dstKeeper.importCode(dstCtx, snapshot.Code.CodeID, snapshot.Code.CodeInfo, snapshot.Code.CodeBytes))
+	require.NoError(t, dstKeeper.importContract(dstCtx, example.Contract, &snapshot.Contract.ContractInfo, snapshot.Contract.ContractState, snapshot.Contract.ContractCodeHistory))
+	gotSnapshot, err := dstKeeper.ExportContract(dstCtx, example.Contract)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, gotSnapshot)
+
+	// and unknown contract
+	_, err = srcKeeper.ExportContract(srcCtx, RandomAccountAddress(t))
+	assert.ErrorIs(t, err, types.ErrNoSuchContractFn(""))
+}
+
+func TestImportContract(t *testing.T) {
+	srcCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, srcCtx, keepers)
+	snapshot, err := keepers.WasmKeeper.ExportContract(srcCtx, example.Contract)
+	require.NoError(t, err)
+
+	dstKeeper, parentCtx := setupKeeper(t)
+	require.NoError(t, dstKeeper.importCode(parentCtx, snapshot.Code.CodeID, snapshot.Code.CodeInfo, snapshot.Code.CodeBytes))
+	existingAddr, existingAdmin := RandomAccountAddress(t), RandomAccountAddress(t)
+	existingInfo := snapshot.Contract.ContractInfo
+	existingInfo.Admin = existingAdmin.String()
+	otherState := []types.Model{{Key: []byte("other"), Value: []byte("value")}}
+	require.NoError(t, dstKeeper.importContract(parentCtx, existingAddr, &existingInfo, otherState, snapshot.Contract.ContractCodeHistory))
+
+	specs := map[string]struct {
+		addr     sdk.AccAddress
+		snapshot func() types.GenesisContract
+		force    bool
+		expErr   error
+		// number of contracts for the code after import
+		expContracts int
+	}{
+		"same address": {
+			addr:         example.Contract,
+			expContracts: 2,
+		},
+		"new address": {
+			addr:         RandomAccountAddress(t),
+			expContracts: 2,
+		},
+		"existing address with force": {
+			addr:         existingAddr,
+			force:        true,
+			expContracts: 1,
+		},
+		"existing address": {
+			addr:   existingAddr,
+			expErr: types.ErrDuplicate,
+		},
+		"missing code id": {
+			addr: RandomAccountAddress(t),
+			snapshot: func() types.GenesisContract {
+				s := snapshot
+				s.Contract.ContractInfo.CodeID = 99
+				return s
+			},
+			expErr: types.ErrNoSuchCodeFn(99),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			src := snapshot
+			if spec.snapshot != nil {
+				src = spec.snapshot()
+			}
+
+			// when
+			gotErr := dstKeeper.ImportContract(ctx, spec.addr, src, spec.force)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			got, err := dstKeeper.ExportContract(ctx, spec.addr)
+			require.NoError(t, err)
+			assert.Equal(t, snapshot.Contract.ContractInfo, got.Contract.ContractInfo)
+			assert.Equal(t, snapshot.Contract.ContractState, got.Contract.ContractState)
+			assert.Equal(t, snapshot.Contract.ContractCodeHistory, got.Contract.ContractCodeHistory)
+			var byCode []sdk.AccAddress
+			dstKeeper.IterateContractsByCode(ctx, snapshot.Code.CodeID, func(addr sdk.AccAddress) bool {
+				byCode = append(byCode, addr)
+				return false
+			})
+			assert.Contains(t, byCode, spec.addr)
+			assert.Len(t, byCode, spec.expContracts)
+			// the admin index of a replaced contract is cleaned up
+			byAdmin, err := dstKeeper.ContractsByAdmin(ctx, existingAdmin)
+			require.NoError(t, err)
+			assert.NotContains(t, byAdmin, spec.addr)
+			if snapshot.Contract.ContractInfo.Admin != "" {
+				byAdmin, err = dstKeeper.ContractsByAdmin(ctx, snapshot.Contract.ContractInfo.AdminAddr())
+				require.NoError(t, err)
+				assert.Contains(t, byAdmin, spec.addr)
+			}
+		})
+	}
+}
+
 func TestImportContractWithCodeHistoryPreserved(t *testing.T) {
 	genesisTemplate := `
 {
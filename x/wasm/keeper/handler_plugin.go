@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -46,6 +48,12 @@ func NewDefaultMessageHandler(
 	customEncoders ...*MessageEncoders,
 ) Messenger {
 	encoders := DefaultEncoders(cdc, portSource)
+	if keeper != nil {
+		// read the port id prefix on use as it is set by a keeper option after this handler was created
+		encoders.IBC = encodeIBCMsg(portSource, func(addr sdk.AccAddress) string {
+			return keeper.PortIDForContract(addr)
+		})
+	}
 	for _, e := range customEncoders {
 		encoders = encoders.Merge(e)
 	}
@@ -124,6 +132,9 @@ func (h SDKMessageHandler) handleSdkMessage(ctx sdk.Context, contractAddr sdk.Ad
 type callDepthMessageHandler struct {
 	Messenger
 	MaxCallDepth uint32
+	// gas charged for a wasm message: DepthGasBase + DepthGasPerLevel * call depth
+	DepthGasBase     uint64
+	DepthGasPerLevel uint64
 }
 
 func (h callDepthMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
@@ -131,6 +142,10 @@ func (h callDepthMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.A
 	if err != nil {
 		return nil, nil, nil, errorsmod.Wrap(err, "dispatch")
 	}
+	if msg.Wasm != nil && (h.DepthGasBase != 0 || h.DepthGasPerLevel != 0) {
+		callDepth, _ := types.CallDepth(ctx)
+		ctx.GasMeter().ConsumeGas(h.DepthGasBase+h.DepthGasPerLevel*uint64(callDepth), "wasm msg call depth")
+	}
 
 	return h.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -258,6 +273,54 @@ func (h IBCRawPacketHandler) DispatchMsg(ctx sdk.Context, _ sdk.AccAddress, cont
 	}
 }
 
+// IBC2WriteAckHandler handles IBC2.WriteAcknowledgement messages. It writes the async acknowledgement for a
+// packet that was received by the contract before. The handler is not part of the default handler chain and
+// can be set up with the WithMessageHandlerDecorator option.
+type IBC2WriteAckHandler struct {
+	channelKeeper types.ChannelKeeperV2
+}
+
+// NewIBC2WriteAckHandler constructor
+func NewIBC2WriteAckHandler(channelKeeper types.ChannelKeeperV2) IBC2WriteAckHandler {
+	return IBC2WriteAckHandler{channelKeeper: channelKeeper}
+}
+
+// DispatchMsg writes the success or error acknowledgement for an async IBCv2 packet.
+func (h IBC2WriteAckHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.IBC2 == nil || msg.IBC2.WriteAcknowledgement == nil {
+		return nil, nil, nil, types.ErrUnknownMsg
+	}
+	writeAck := msg.IBC2.WriteAcknowledgement
+	ack, _, err := ConvertIBC2Acknowledgement(writeAck.Ack)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "acknowledgement")
+	}
+	packet, ok := h.channelKeeper.GetAsyncPacket(ctx, writeAck.SourceClient, writeAck.PacketSequence)
+	if !ok {
+		return nil, nil, nil, errorsmod.Wrap(types.ErrInvalid, "packet")
+	}
+	contractPortID := PortIDForContractV2(contractAddr)
+	for _, p := range packet.Payloads {
+		if p.DestinationPort != contractPortID {
+			return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "packet not received by contract")
+		}
+	}
+	if err := h.channelKeeper.WriteAcknowledgement(ctx, writeAck.SourceClient, writeAck.PacketSequence, ack); err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "acknowledgement")
+	}
+
+	resp := &types.MsgIBCWriteAcknowledgementResponse{}
+	val, err := resp.Marshal()
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "failed to marshal IBC write acknowledgement response")
+	}
+	any, err := codectypes.NewAnyWithValue(resp)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "failed to convert IBC write acknowledgement response to Any")
+	}
+	return nil, [][]byte{val}, [][]*codectypes.Any{{any}}, nil
+}
+
 var _ Messenger = MessageHandlerFunc(nil)
 
 // MessageHandlerFunc is a helper to construct a function based message handler.
@@ -291,3 +354,97 @@ func NewBurnCoinMessageHandler(burner types.Burner) MessageHandlerFunc {
 		return nil, nil, nil, types.ErrUnknownMsg
 	}
 }
+
+// The opt-in handlers below are added in front of the default handler, for example with
+// WithMessageHandlerDecorator. Messages that they do not handle or that pass their check are returned with
+// types.ErrUnknownMsg, so that they are passed on to the next handler in a MessageHandlerChain.
+
+// NewClearAdminCheckMessageHandler is an opt-in handler that rejects a wasm ClearAdmin message early when the
+// sending contract is not the admin of the target contract. Without this handler, the check is done by the
+// keeper on execution.
+func NewClearAdminCheckMessageHandler(source contractMetaDataSource) MessageHandlerFunc {
+	return func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+		if msg.Wasm == nil || msg.Wasm.ClearAdmin == nil {
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+		targetAddr := msg.Wasm.ClearAdmin.ContractAddr
+		addr, err := sdk.AccAddressFromBech32(targetAddr)
+		if err != nil {
+			return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, targetAddr)
+		}
+		info := source.GetContractInfo(ctx, addr)
+		if info == nil {
+			return nil, nil, nil, types.ErrNoSuchContractFn(targetAddr).Wrapf("address %s", targetAddr)
+		}
+		if info.Admin != contractAddr.String() {
+			return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "clear admin: sender is not the admin")
+		}
+		return nil, nil, nil, types.ErrUnknownMsg
+	}
+}
+
+// NewUniqueLabelCheckMessageHandler is an opt-in handler that rejects a wasm Instantiate or Instantiate2 message
+// with a label for which the given predicate returns true because it is used by another contract already. The
+// default handlers permit duplicate labels.
+func NewUniqueLabelCheckMessageHandler(isLabelTaken func(ctx sdk.Context, label string) bool) MessageHandlerFunc {
+	return func(ctx sdk.Context, _ sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+		var label string
+		switch {
+		case msg.Wasm == nil:
+			return nil, nil, nil, types.ErrUnknownMsg
+		case msg.Wasm.Instantiate != nil:
+			label = msg.Wasm.Instantiate.Label
+		case msg.Wasm.Instantiate2 != nil:
+			label = msg.Wasm.Instantiate2.Label
+		default:
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+		if isLabelTaken(ctx, label) {
+			return nil, nil, nil, errorsmod.Wrapf(types.ErrDuplicate, "label: %s", label)
+		}
+		return nil, nil, nil, types.ErrUnknownMsg
+	}
+}
+
+type spendableCoinsSource interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// NewSpendableBalanceCheckMessageHandler is an opt-in handler that rejects a bank Send message early when the
+// amount exceeds the spendable balance of the sending contract, for example due to vesting. The error names the
+// spendable amount.
+func NewSpendableBalanceCheckMessageHandler(source spendableCoinsSource) MessageHandlerFunc {
+	return func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+		if msg.Bank == nil || msg.Bank.Send == nil {
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+		amount, err := ConvertWasmCoinsToSdkCoins(msg.Bank.Send.Amount)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if spendable := source.SpendableCoins(ctx, contractAddr); !spendable.IsAllGTE(amount) {
+			return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, amount)
+		}
+		return nil, nil, nil, types.ErrUnknownMsg
+	}
+}
+
+// CustomDataEncoder converts a custom contract message into response data only. No sdk message is executed.
+// Messages that are not supported must return types.ErrUnknownMsg so that they are passed to the next handler.
+type CustomDataEncoder func(sender sdk.AccAddress, msg json.RawMessage) ([]byte, error)
+
+// NewCustomDataMessageHandler is an opt-in handler for custom messages that only record data, for example for
+// off-chain consumption. The data is returned as message response data so that it is available in a reply of
+// a submessage.
+func NewCustomDataMessageHandler(encoder CustomDataEncoder) MessageHandlerFunc {
+	return func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+		if msg.Custom == nil {
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+		bz, err := encoder(contractAddr, msg.Custom)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return nil, [][]byte{bz}, nil, nil
+	}
+}
@@ -3,6 +3,11 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
@@ -10,13 +15,18 @@ import (
 	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -29,6 +39,12 @@ import (
 // With the default gas multiplier, this amounts to 5 SDK gas.
 const anyMsgGasCost = 700000
 
+// Besides the encoders for the wasmvm CosmosMsg variants, this file provides helpers for chain specific setups:
+//   - Opt-in encoders wrap an encoder of a variant with an additional check. Some of them reject messages that
+//     would fail on dispatch anyway, only earlier and with a clear error.
+//   - The XxxMsg types describe messages that are not part of the wasmvm CosmosMsg variants. Contracts send them
+//     as custom messages. A chain's custom encoder decodes them and passes them on to the matching EncodeXxx helper.
+
 type (
 	BankEncoder         func(sender sdk.AccAddress, msg *wasmvmtypes.BankMsg) ([]sdk.Msg, error)
 	CustomEncoder       func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error)
@@ -40,6 +56,9 @@ type (
 	IBC2Encoder         func(sender sdk.AccAddress, msg *wasmvmtypes.IBC2Msg) ([]sdk.Msg, error)
 )
 
+// ContextStakingEncoder is a staking encoder that can read state
+type ContextStakingEncoder func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.StakingMsg) ([]sdk.Msg, error)
+
 type MessageEncoders struct {
 	Bank         func(sender sdk.AccAddress, msg *wasmvmtypes.BankMsg) ([]sdk.Msg, error)
 	Custom       func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error)
@@ -50,6 +69,25 @@ type MessageEncoders struct {
 	Any          func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.AnyMsg) ([]sdk.Msg, error)
 	Wasm         func(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error)
 	Gov          func(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, error)
+	// EmitEncodedMsgsEvent enables an event with the number and type urls of the sdk messages
+	// produced for each contract message. Disabled by default to not add overhead.
+	EmitEncodedMsgsEvent bool
+	// StakingWithContext optionally replaces Staking with an encoder that can read state,
+	// for example EncodeStakingMsgWithRedelegationLimit. Not set by default.
+	StakingWithContext ContextStakingEncoder
+	// Budget optionally limits the complexity of a contract message. Messages that exceed it are
+	// rejected before they are encoded. Not set by default.
+	Budget *EncodeBudget
+	// DenomValidator is an optional chain specific denom rule that is applied to the coins of a contract
+	// message on top of the sdk validation. Not set by default so that only the sdk rules apply.
+	DenomValidator func(denom string) error
+	// ConvertDisplayUnits enables the conversion of the coins of a contract message from display units to
+	// base units. The DenomMetadataGetter must be set, too, Merge panics otherwise. Disabled by default so
+	// that all amounts are base units.
+	ConvertDisplayUnits bool
+	// DenomMetadataGetter returns the bank metadata for a display denom from the current state. Coins with
+	// a denom without metadata are not converted.
+	DenomMetadataGetter func(ctx sdk.Context, displayDenom string) (banktypes.Metadata, bool)
 }
 
 func DefaultEncoders(unpacker codectypes.AnyUnpacker, portSource types.ICS20TransferPortSource) MessageEncoders {
@@ -88,6 +126,9 @@ func (e MessageEncoders) Merge(o *MessageEncoders) MessageEncoders {
 	if o.Staking != nil {
 		e.Staking = o.Staking
 	}
+	if o.StakingWithContext != nil {
+		e.StakingWithContext = o.StakingWithContext
+	}
 	if o.Any != nil {
 		e.Any = o.Any
 	}
@@ -97,10 +138,277 @@ func (e MessageEncoders) Merge(o *MessageEncoders) MessageEncoders {
 	if o.Gov != nil {
 		e.Gov = o.Gov
 	}
+	if o.EmitEncodedMsgsEvent {
+		e.EmitEncodedMsgsEvent = true
+	}
+	if o.Budget != nil {
+		e.Budget = o.Budget
+	}
+	if o.DenomValidator != nil {
+		e.DenomValidator = o.DenomValidator
+	}
+	if o.ConvertDisplayUnits {
+		e.ConvertDisplayUnits = true
+	}
+	if o.DenomMetadataGetter != nil {
+		e.DenomMetadataGetter = o.DenomMetadataGetter
+	}
+	if e.ConvertDisplayUnits && e.DenomMetadataGetter == nil {
+		panic("denom metadata getter must be set to convert display units")
+	}
 	return e
 }
 
 func (e MessageEncoders) Encode(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Msg, error) {
+	if e.Budget != nil {
+		if err := e.Budget.Check(msg); err != nil {
+			return nil, err
+		}
+	}
+	if e.ConvertDisplayUnits {
+		var err error
+		if msg, err = mapWasmMsgCoins(msg, func(coin wasmvmtypes.Coin) (wasmvmtypes.Coin, error) {
+			return e.convertDisplayUnits(ctx, coin)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if e.DenomValidator != nil {
+		var err error
+		if msg, err = mapWasmMsgCoins(msg, e.validateDenom); err != nil {
+			return nil, err
+		}
+	}
+	sdkMsgs, err := e.encode(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil {
+		return nil, err
+	}
+	telemetry.IncrCounterWithLabels([]string{"wasm", "contract", "msg", "encoded"}, 1, []metrics.Label{telemetry.NewLabel("variant", cosmosMsgVariant(msg))})
+	if !e.EmitEncodedMsgsEvent {
+		return sdkMsgs, nil
+	}
+	attrs := make([]sdk.Attribute, 0, len(sdkMsgs)+2)
+	attrs = append(attrs,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyMsgCount, strconv.Itoa(len(sdkMsgs))),
+	)
+	for _, m := range sdkMsgs {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(m)))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEncodedMsgs, attrs...))
+	return sdkMsgs, nil
+}
+
+// validateDenom applies the chain specific denom rule to the given coin
+func (e MessageEncoders) validateDenom(coin wasmvmtypes.Coin) (wasmvmtypes.Coin, error) {
+	if err := e.DenomValidator(coin.Denom); err != nil {
+		return coin, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "denom %s: %s", coin.Denom, err)
+	}
+	return coin, nil
+}
+
+// convertDisplayUnits converts the given coin to base units when metadata exists for its denom
+func (e MessageEncoders) convertDisplayUnits(ctx sdk.Context, coin wasmvmtypes.Coin) (wasmvmtypes.Coin, error) {
+	metadata, ok := e.DenomMetadataGetter(ctx, coin.Denom)
+	if !ok {
+		return coin, nil
+	}
+	return convertDisplayCoinToBaseUnits(coin, metadata)
+}
+
+// mapWasmMsgCoins returns a copy of the given message with the coins replaced by the results of fn. The coins of
+// the bank, staking, distribution, wasm and ICS20 transfer variants are visited. Custom and any messages are opaque.
+func mapWasmMsgCoins(msg wasmvmtypes.CosmosMsg, fn func(wasmvmtypes.Coin) (wasmvmtypes.Coin, error)) (wasmvmtypes.CosmosMsg, error) {
+	mapAll := func(coins wasmvmtypes.Array[wasmvmtypes.Coin]) (wasmvmtypes.Array[wasmvmtypes.Coin], error) {
+		if coins == nil {
+			return nil, nil
+		}
+		r := make(wasmvmtypes.Array[wasmvmtypes.Coin], len(coins))
+		for i, c := range coins {
+			var err error
+			if r[i], err = fn(c); err != nil {
+				return nil, err
+			}
+		}
+		return r, nil
+	}
+	var err error
+	switch {
+	case msg.Bank != nil:
+		bank := *msg.Bank
+		if bank.Send != nil {
+			send := *bank.Send
+			send.Amount, err = mapAll(send.Amount)
+			bank.Send = &send
+		}
+		if err == nil && bank.Burn != nil {
+			burn := *bank.Burn
+			burn.Amount, err = mapAll(burn.Amount)
+			bank.Burn = &burn
+		}
+		msg.Bank = &bank
+	case msg.Staking != nil:
+		staking := *msg.Staking
+		switch {
+		case staking.Delegate != nil:
+			delegate := *staking.Delegate
+			delegate.Amount, err = fn(delegate.Amount)
+			staking.Delegate = &delegate
+		case staking.Undelegate != nil:
+			undelegate := *staking.Undelegate
+			undelegate.Amount, err = fn(undelegate.Amount)
+			staking.Undelegate = &undelegate
+		case staking.Redelegate != nil:
+			redelegate := *staking.Redelegate
+			redelegate.Amount, err = fn(redelegate.Amount)
+			staking.Redelegate = &redelegate
+		}
+		msg.Staking = &staking
+	case msg.Distribution != nil && msg.Distribution.FundCommunityPool != nil:
+		distribution := *msg.Distribution
+		fund := *distribution.FundCommunityPool
+		fund.Amount, err = mapAll(fund.Amount)
+		distribution.FundCommunityPool = &fund
+		msg.Distribution = &distribution
+	case msg.Wasm != nil:
+		wasm := *msg.Wasm
+		switch {
+		case wasm.Execute != nil:
+			execute := *wasm.Execute
+			execute.Funds, err = mapAll(execute.Funds)
+			wasm.Execute = &execute
+		case wasm.Instantiate != nil:
+			instantiate := *wasm.Instantiate
+			instantiate.Funds, err = mapAll(instantiate.Funds)
+			wasm.Instantiate = &instantiate
+		case wasm.Instantiate2 != nil:
+			instantiate2 := *wasm.Instantiate2
+			instantiate2.Funds, err = mapAll(instantiate2.Funds)
+			wasm.Instantiate2 = &instantiate2
+		}
+		msg.Wasm = &wasm
+	case msg.IBC != nil && msg.IBC.Transfer != nil:
+		ibc := *msg.IBC
+		transfer := *ibc.Transfer
+		transfer.Amount, err = fn(transfer.Amount)
+		ibc.Transfer = &transfer
+		msg.IBC = &ibc
+	}
+	return msg, err
+}
+
+// cosmosMsgVariant returns the name of the variant set in the given message for metrics
+func cosmosMsgVariant(msg wasmvmtypes.CosmosMsg) string {
+	switch {
+	case msg.Bank != nil:
+		return "bank"
+	case msg.Custom != nil:
+		return "custom"
+	case msg.Distribution != nil:
+		return "distribution"
+	case msg.IBC != nil:
+		return "ibc"
+	case msg.IBC2 != nil:
+		return "ibc2"
+	case msg.Staking != nil:
+		return "staking"
+	case msg.Any != nil:
+		return "any"
+	case msg.Wasm != nil:
+		return "wasm"
+	case msg.Gov != nil:
+		return "gov"
+	}
+	return "unknown"
+}
+
+// EncodeBudget is a complexity budget for a single contract message that is checked before the message is
+// encoded. This is a defense-in-depth measure on top of gas. A zero value disables the dimension.
+type EncodeBudget struct {
+	// MaxCoins is the max number of coins in a coin list of the message
+	MaxCoins int
+	// MaxNesting is the max nesting depth of json objects and arrays in embedded contract or custom messages
+	MaxNesting int
+	// MaxAnyValueSize is the max size in bytes of the protobuf value of an AnyMsg
+	MaxAnyValueSize int
+}
+
+// Check returns an ErrLimit error when the given message exceeds the budget
+func (b EncodeBudget) Check(msg wasmvmtypes.CosmosMsg) error {
+	var coins []wasmvmtypes.Array[wasmvmtypes.Coin]
+	var jsonMsgs [][]byte
+	switch {
+	case msg.Bank != nil && msg.Bank.Send != nil:
+		coins = append(coins, msg.Bank.Send.Amount)
+	case msg.Bank != nil && msg.Bank.Burn != nil:
+		coins = append(coins, msg.Bank.Burn.Amount)
+	case msg.Distribution != nil && msg.Distribution.FundCommunityPool != nil:
+		coins = append(coins, msg.Distribution.FundCommunityPool.Amount)
+	case msg.Custom != nil:
+		jsonMsgs = append(jsonMsgs, msg.Custom)
+	case msg.Any != nil:
+		if b.MaxAnyValueSize != 0 && len(msg.Any.Value) > b.MaxAnyValueSize {
+			return errorsmod.Wrapf(types.ErrLimit, "any value size: %d > %d", len(msg.Any.Value), b.MaxAnyValueSize)
+		}
+	case msg.Wasm != nil && msg.Wasm.Execute != nil:
+		coins = append(coins, msg.Wasm.Execute.Funds)
+		jsonMsgs = append(jsonMsgs, msg.Wasm.Execute.Msg)
+	case msg.Wasm != nil && msg.Wasm.Instantiate != nil:
+		coins = append(coins, msg.Wasm.Instantiate.Funds)
+		jsonMsgs = append(jsonMsgs, msg.Wasm.Instantiate.Msg)
+	case msg.Wasm != nil && msg.Wasm.Instantiate2 != nil:
+		coins = append(coins, msg.Wasm.Instantiate2.Funds)
+		jsonMsgs = append(jsonMsgs, msg.Wasm.Instantiate2.Msg)
+	case msg.Wasm != nil && msg.Wasm.Migrate != nil:
+		jsonMsgs = append(jsonMsgs, msg.Wasm.Migrate.Msg)
+	}
+	if b.MaxCoins != 0 {
+		for _, c := range coins {
+			if len(c) > b.MaxCoins {
+				return errorsmod.Wrapf(types.ErrLimit, "coins: %d > %d", len(c), b.MaxCoins)
+			}
+		}
+	}
+	if b.MaxNesting != 0 {
+		for _, m := range jsonMsgs {
+			if depth := jsonNestingDepth(m); depth > b.MaxNesting {
+				return errorsmod.Wrapf(types.ErrLimit, "nesting: %d > %d", depth, b.MaxNesting)
+			}
+		}
+	}
+	return nil
+}
+
+// jsonNestingDepth returns the max depth of json objects and arrays without decoding the document
+func jsonNestingDepth(bz []byte) int {
+	var depth, maxDepth int
+	var inString, escaped bool
+	for _, c := range bz {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			inString = true
+		case '{', '[':
+			depth++
+			maxDepth = max(maxDepth, depth)
+		case '}', ']':
+			depth--
+		}
+	}
+	return maxDepth
+}
+
+func (e MessageEncoders) encode(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.Bank != nil:
 		return e.Bank(contractAddr, msg.Bank)
@@ -113,8 +421,13 @@ func (e MessageEncoders) Encode(ctx sdk.Context, contractAddr sdk.AccAddress, co
 	case msg.IBC2 != nil:
 		return e.IBC2(contractAddr, msg.IBC2)
 	case msg.Staking != nil:
+		if e.StakingWithContext != nil {
+			return e.StakingWithContext(ctx, contractAddr, msg.Staking)
+		}
 		return e.Staking(contractAddr, msg.Staking)
 	case msg.Any != nil:
+		// the legacy `stargate` variant is unmarshalled into the Any field by wasmvm,
+		// so that older contracts are handled the same way
 		return e.Any(ctx, contractAddr, msg.Any)
 	case msg.Wasm != nil:
 		return e.Wasm(contractAddr, msg.Wasm)
@@ -143,13 +456,231 @@ func EncodeBankMsg(sender sdk.AccAddress, msg *wasmvmtypes.BankMsg) ([]sdk.Msg,
 	return []sdk.Msg{&sdkMsg}, nil
 }
 
+// EncodeBankMsgRejectEmptySend is an opt-in bank encoder that fails for a send without any amount instead of
+// silently dropping the message as the default encoder does. All other messages are passed to the given encoder.
+func EncodeBankMsgRejectEmptySend(encoder BankEncoder) BankEncoder {
+	return func(sender sdk.AccAddress, msg *wasmvmtypes.BankMsg) ([]sdk.Msg, error) {
+		if msg.Send != nil && len(msg.Send.Amount) == 0 {
+			return nil, errorsmod.Wrap(types.ErrEmpty, "send amount")
+		}
+		return encoder(sender, msg)
+	}
+}
+
+// EncodeBankMsgWithSelfSendCheck is an opt-in bank encoder that detects a send to the sender itself. Such a send
+// is either dropped without any sdk message or rejected when reject is set.
+// All other messages are passed to the given encoder.
+func EncodeBankMsgWithSelfSendCheck(encoder BankEncoder, reject bool) BankEncoder {
+	return func(sender sdk.AccAddress, msg *wasmvmtypes.BankMsg) ([]sdk.Msg, error) {
+		if msg.Send != nil {
+			if to, err := sdk.AccAddressFromBech32(msg.Send.ToAddress); err == nil && to.Equals(sender) {
+				if reject {
+					return nil, errorsmod.Wrap(types.ErrInvalid, "send to self")
+				}
+				return nil, nil
+			}
+		}
+		return encoder(sender, msg)
+	}
+}
+
+// EncodeMultiSend is a helper for custom encoders that batch payouts to many recipients into a
+// single MsgMultiSend. The amount is the total sent by the contract and must match the sum of all outputs.
+func EncodeMultiSend(sender sdk.AccAddress, amount wasmvmtypes.Array[wasmvmtypes.Coin], outputs []wasmvmtypes.SendMsg) ([]sdk.Msg, error) {
+	if len(outputs) == 0 {
+		return nil, banktypes.ErrNoOutputs
+	}
+	total, err := ConvertWasmCoinsToSdkCoins(amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "amount")
+	}
+	input := banktypes.NewInput(sender, total)
+	sdkOutputs := make([]banktypes.Output, len(outputs))
+	for i, o := range outputs {
+		coins, err := ConvertWasmCoinsToSdkCoins(o.Amount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "output %d", i)
+		}
+		sdkOutputs[i] = banktypes.Output{Address: o.ToAddress, Coins: coins}
+	}
+	if err := banktypes.ValidateInputOutputs(input, sdkOutputs); err != nil {
+		return nil, errorsmod.Wrap(err, "multi send")
+	}
+	return []sdk.Msg{banktypes.NewMsgMultiSend(input, sdkOutputs)}, nil
+}
+
+// VestingSendMsg describes a send by a contract to a new vesting account
+type VestingSendMsg struct {
+	ToAddress string                              `json:"to_address"`
+	Amount    wasmvmtypes.Array[wasmvmtypes.Coin] `json:"amount"`
+	// EndTime is the vesting end time in unix seconds
+	EndTime wasmvmtypes.Uint64 `json:"end_time"`
+	// Delayed selects a delayed instead of a continuous vesting account
+	Delayed bool `json:"delayed,omitempty"`
+}
+
+// EncodeVestingSend is a helper for custom encoders to create a vesting account funded by the sender.
+// Vesting sends are chain specific so that chains without the vesting module pass vestingEnabled=false
+// to reject them.
+func EncodeVestingSend(vestingEnabled bool, sender sdk.AccAddress, msg *VestingSendMsg) ([]sdk.Msg, error) {
+	if !vestingEnabled {
+		return nil, errorsmod.Wrap(types.ErrInvalidMsg, "vesting send not supported")
+	}
+	if msg.EndTime == 0 {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "end time")
+	}
+	if uint64(msg.EndTime) > math.MaxInt64 {
+		return nil, errorsmod.Wrapf(types.ErrLimit, "end time: %d", msg.EndTime)
+	}
+	amount, err := ConvertWasmCoinsToSdkCoins(msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "amount")
+	}
+	if amount.Empty() {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "amount")
+	}
+	return []sdk.Msg{&vestingtypes.MsgCreateVestingAccount{
+		FromAddress: sender.String(),
+		ToAddress:   msg.ToAddress,
+		Amount:      amount,
+		EndTime:     int64(msg.EndTime),
+		Delayed:     msg.Delayed,
+	}}, nil
+}
+
+// DenomResolver maps a denom sent by a contract to the denom used by the bank module
+type DenomResolver func(denom string) (string, error)
+
+// IBCDenomTraceResolver resolves an ICS20 denom path like `transfer/channel-0/uatom` into the `ibc/{hash}` form.
+// Any other denom is returned unchanged.
+func IBCDenomTraceResolver(denom string) (string, error) {
+	return ibctransfertypes.ExtractDenomFromPath(denom).IBCDenom(), nil
+}
+
+// EncodeBankMsgWithDenomResolver is an opt-in bank encoder that resolves the denoms of the coins to send
+// before passing the message to the given encoder.
+func EncodeBankMsgWithDenomResolver(encoder BankEncoder, resolve DenomResolver) BankEncoder {
+	return func(sender sdk.AccAddress, msg *wasmvmtypes.BankMsg) ([]sdk.Msg, error) {
+		if msg.Send == nil {
+			return encoder(sender, msg)
+		}
+		amount, err := resolveWasmCoinDenoms(msg.Send.Amount, resolve)
+		if err != nil {
+			return nil, err
+		}
+		send := *msg.Send
+		send.Amount = amount
+		return encoder(sender, &wasmvmtypes.BankMsg{Send: &send})
+	}
+}
+
+// EncodeIBCMsgWithDenomResolver is an opt-in ibc encoder that resolves the denom of an ICS20 transfer
+// before passing the message to the given encoder.
+func EncodeIBCMsgWithDenomResolver(encoder IBCEncoder, resolve DenomResolver) IBCEncoder {
+	return func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error) {
+		if msg.Transfer == nil {
+			return encoder(ctx, sender, contractIBCPortID, msg)
+		}
+		denom, err := resolve(msg.Transfer.Amount.Denom)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "denom")
+		}
+		transfer := *msg.Transfer
+		transfer.Amount.Denom = denom
+		return encoder(ctx, sender, contractIBCPortID, &wasmvmtypes.IBCMsg{Transfer: &transfer})
+	}
+}
+
+// ReceiverValidator validates the counterparty receiver address of an ICS20 transfer
+type ReceiverValidator func(receiver string) error
+
+// MaxLengthReceiverValidator is a ReceiverValidator that rejects empty receivers or receivers longer than maxLen.
+// The address format of the counterparty chain is not checked.
+func MaxLengthReceiverValidator(maxLen int) ReceiverValidator {
+	return func(receiver string) error {
+		switch {
+		case strings.TrimSpace(receiver) == "":
+			return errorsmod.Wrap(types.ErrEmpty, "receiver")
+		case len(receiver) > maxLen:
+			return errorsmod.Wrapf(types.ErrLimit, "receiver length: %d > %d", len(receiver), maxLen)
+		}
+		return nil
+	}
+}
+
+// EncodeIBCMsgWithReceiverValidator is an opt-in ibc encoder that validates the receiver of an ICS20 transfer
+// before passing the message to the given encoder. The default encoders do not validate receivers so that
+// any counterparty address format is supported.
+func EncodeIBCMsgWithReceiverValidator(encoder IBCEncoder, validate ReceiverValidator) IBCEncoder {
+	return func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error) {
+		if msg.Transfer != nil {
+			if err := validate(msg.Transfer.ToAddress); err != nil {
+				return nil, errorsmod.Wrap(err, "to address")
+			}
+		}
+		return encoder(ctx, sender, contractIBCPortID, msg)
+	}
+}
+
+// EncodeIBCMsgWithRestrictedDenoms is an opt-in ibc encoder that rejects an ICS20 transfer of a denom for which
+// the given predicate returns true, for example staking derivatives. The default encoders allow all denoms.
+// All other messages are passed to the given encoder.
+func EncodeIBCMsgWithRestrictedDenoms(encoder IBCEncoder, isRestricted func(denom string) bool) IBCEncoder {
+	return func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error) {
+		if msg.Transfer != nil && isRestricted(msg.Transfer.Amount.Denom) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "ibc transfer of restricted denom: %s", msg.Transfer.Amount.Denom)
+		}
+		return encoder(ctx, sender, contractIBCPortID, msg)
+	}
+}
+
+func resolveWasmCoinDenoms(coins wasmvmtypes.Array[wasmvmtypes.Coin], resolve DenomResolver) (wasmvmtypes.Array[wasmvmtypes.Coin], error) {
+	r := make(wasmvmtypes.Array[wasmvmtypes.Coin], len(coins))
+	for i, c := range coins {
+		denom, err := resolve(c.Denom)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "denom")
+		}
+		r[i] = wasmvmtypes.Coin{Denom: denom, Amount: c.Amount}
+	}
+	return r, nil
+}
+
 func NoCustomMsg(_ sdk.AccAddress, _ json.RawMessage) ([]sdk.Msg, error) {
 	return nil, errorsmod.Wrap(types.ErrUnknownMsg, "custom variant not supported")
 }
 
+// EncodeCustomMsgWithNoop is an opt-in custom encoder that accepts the reserved `{"noop":{}}` message and
+// encodes it to no sdk messages. Contracts can use it to emit their own events without any other action.
+// All other custom messages are passed to the given encoder.
+func EncodeCustomMsgWithNoop(encoder CustomEncoder) CustomEncoder {
+	return func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		if isNoopMsg(msg) {
+			return nil, nil
+		}
+		return encoder(sender, msg)
+	}
+}
+
+func isNoopMsg(msg json.RawMessage) bool {
+	var outer map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &outer); err != nil || len(outer) != 1 {
+		return false
+	}
+	noop, ok := outer["noop"]
+	if !ok {
+		return false
+	}
+	var inner map[string]json.RawMessage
+	return json.Unmarshal(noop, &inner) == nil && inner != nil && len(inner) == 0
+}
+
 func EncodeDistributionMsg(sender sdk.AccAddress, msg *wasmvmtypes.DistributionMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.SetWithdrawAddress != nil:
+		if _, err := sdk.AccAddressFromBech32(msg.SetWithdrawAddress.Address); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, msg.SetWithdrawAddress.Address)
+		}
 		setMsg := distributiontypes.MsgSetWithdrawAddress{
 			DelegatorAddress: sender.String(),
 			WithdrawAddress:  msg.SetWithdrawAddress.Address,
@@ -176,6 +707,33 @@ func EncodeDistributionMsg(sender sdk.AccAddress, msg *wasmvmtypes.DistributionM
 	}
 }
 
+// EncodeDistributionMsgWithBlockedWithdrawAddresses is an opt-in distribution encoder that rejects a set withdraw
+// address message for which the given predicate returns true, for example a module account.
+// All other messages are passed to the given encoder.
+func EncodeDistributionMsgWithBlockedWithdrawAddresses(encoder DistributionEncoder, isBlocked func(addr string) bool) DistributionEncoder {
+	return func(sender sdk.AccAddress, msg *wasmvmtypes.DistributionMsg) ([]sdk.Msg, error) {
+		if msg.SetWithdrawAddress != nil && isBlocked(msg.SetWithdrawAddress.Address) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "blocked withdraw address: %s", msg.SetWithdrawAddress.Address)
+		}
+		return encoder(sender, msg)
+	}
+}
+
+// EncodeDepositValidatorRewardsPool is a helper for custom encoders to deposit into the rewards pool of a validator.
+// The message is not part of the wasmvm distribution variants and not supported by all SDK versions.
+func EncodeDepositValidatorRewardsPool(sender sdk.AccAddress, validator string, amount wasmvmtypes.Array[wasmvmtypes.Coin]) ([]sdk.Msg, error) {
+	amt, err := ConvertWasmCoinsToSdkCoins(amount)
+	if err != nil {
+		return nil, err
+	}
+	depositMsg := distributiontypes.MsgDepositValidatorRewardsPool{
+		Depositor:        sender.String(),
+		ValidatorAddress: validator,
+		Amount:           amt,
+	}
+	return []sdk.Msg{&depositMsg}, nil
+}
+
 func EncodeStakingMsg(sender sdk.AccAddress, msg *wasmvmtypes.StakingMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.Delegate != nil:
@@ -218,6 +776,87 @@ func EncodeStakingMsg(sender sdk.AccAddress, msg *wasmvmtypes.StakingMsg) ([]sdk
 	}
 }
 
+// EncodeStakingMsgRejectZeroAmount is an opt-in staking encoder that rejects a delegate, undelegate or
+// redelegate with a zero amount with a clear error instead of failing later in the staking module.
+func EncodeStakingMsgRejectZeroAmount(encoder StakingEncoder) StakingEncoder {
+	return func(sender sdk.AccAddress, msg *wasmvmtypes.StakingMsg) ([]sdk.Msg, error) {
+		var op string
+		var amount wasmvmtypes.Coin
+		switch {
+		case msg.Delegate != nil:
+			op, amount = "delegate", msg.Delegate.Amount
+		case msg.Undelegate != nil:
+			op, amount = "undelegate", msg.Undelegate.Amount
+		case msg.Redelegate != nil:
+			op, amount = "redelegate", msg.Redelegate.Amount
+		default:
+			return encoder(sender, msg)
+		}
+		if amt, ok := sdkmath.NewIntFromString(amount.Amount); ok && amt.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrEmpty, "%s amount", op)
+		}
+		return encoder(sender, msg)
+	}
+}
+
+// RedelegationEntriesCounter returns the number of redelegation entries stored for the delegator and validator pair
+type RedelegationEntriesCounter func(ctx sdk.Context, delegator sdk.AccAddress, srcValidator, dstValidator string) (uint32, error)
+
+// EncodeStakingMsgWithRedelegationLimit is an opt-in staking encoder that rejects a redelegation
+// early when the delegator/validator pair has reached the max entries already.
+// All other staking messages are passed to the given encoder. Set it as MessageEncoders.StakingWithContext.
+func EncodeStakingMsgWithRedelegationLimit(encoder StakingEncoder, counter RedelegationEntriesCounter, maxEntries uint32) ContextStakingEncoder {
+	return func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.StakingMsg) ([]sdk.Msg, error) {
+		if msg.Redelegate != nil {
+			entries, err := counter(ctx, sender, msg.Redelegate.SrcValidator, msg.Redelegate.DstValidator)
+			if err != nil {
+				return nil, err
+			}
+			if entries >= maxEntries {
+				return nil, errorsmod.Wrapf(types.ErrLimit, "max redelegation entries: %d", maxEntries)
+			}
+		}
+		return encoder(sender, msg)
+	}
+}
+
+// AutoCompoundMsgFactory returns the chain specific message that enables auto-compounding of the
+// rewards for the given delegator and validator pair.
+type AutoCompoundMsgFactory func(delegator sdk.AccAddress, validator string) (sdk.Msg, error)
+
+// DelegateWithAutoCompoundMsg describes a delegation by a contract that can be marked for auto-compounding
+type DelegateWithAutoCompoundMsg struct {
+	Validator    string           `json:"validator"`
+	Amount       wasmvmtypes.Coin `json:"amount"`
+	AutoCompound bool             `json:"auto_compound"`
+}
+
+// EncodeDelegateWithAutoCompound is a helper for custom encoders to delegate with the sender as delegator.
+// When the auto compound flag is set, the message returned by the factory is appended after the delegation.
+// Chains without auto-compounding pass a nil factory so that the flag is rejected.
+func EncodeDelegateWithAutoCompound(enableAutoCompound AutoCompoundMsgFactory, sender sdk.AccAddress, msg *DelegateWithAutoCompoundMsg) ([]sdk.Msg, error) {
+	coin, err := ConvertWasmCoinToSdkCoin(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	result := []sdk.Msg{&stakingtypes.MsgDelegate{
+		DelegatorAddress: sender.String(),
+		ValidatorAddress: msg.Validator,
+		Amount:           coin,
+	}}
+	if !msg.AutoCompound {
+		return result, nil
+	}
+	if enableAutoCompound == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMsg, "auto compound not supported")
+	}
+	autoCompoundMsg, err := enableAutoCompound(sender, msg.Validator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "auto compound")
+	}
+	return append(result, autoCompoundMsg), nil
+}
+
 func EncodeAnyMsg(unpacker codectypes.AnyUnpacker) AnyEncoder {
 	return func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.AnyMsg) ([]sdk.Msg, error) {
 		codecAny := codectypes.Any{
@@ -237,6 +876,83 @@ func EncodeAnyMsg(unpacker codectypes.AnyUnpacker) AnyEncoder {
 	}
 }
 
+// EncodeAnyMsgWithSignerCheck is an opt-in any encoder that rejects an sdk message with a signer other than the
+// sending contract.
+func EncodeAnyMsgWithSignerCheck(encoder AnyEncoder, cdc codec.Codec) AnyEncoder {
+	return func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.AnyMsg) ([]sdk.Msg, error) {
+		sdkMsgs, err := encoder(ctx, sender, msg)
+		if err != nil {
+			return nil, err
+		}
+		for _, sdkMsg := range sdkMsgs {
+			signers, _, err := cdc.GetMsgV1Signers(sdkMsg)
+			if err != nil {
+				return nil, errorsmod.Wrap(types.ErrInvalidMsg, err.Error())
+			}
+			for _, signer := range signers {
+				if !sender.Equals(sdk.AccAddress(signer)) {
+					return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the contract", sdk.AccAddress(signer))
+				}
+			}
+		}
+		return sdkMsgs, nil
+	}
+}
+
+// EncodeAnyMsgWithRouterCheck is an opt-in any encoder that rejects sdk messages without a handler registered
+// in the given router.
+func EncodeAnyMsgWithRouterCheck(encoder AnyEncoder, router MessageRouter) AnyEncoder {
+	return func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.AnyMsg) ([]sdk.Msg, error) {
+		sdkMsgs, err := encoder(ctx, sender, msg)
+		if err != nil {
+			return nil, err
+		}
+		for _, sdkMsg := range sdkMsgs {
+			if router.Handler(sdkMsg) == nil {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %s", sdk.MsgTypeURL(sdkMsg))
+			}
+		}
+		return sdkMsgs, nil
+	}
+}
+
+// AnyTypeURLRule allows or denies the sdk messages matching the pattern in EncodeAnyMsgWithTypeURLRules.
+// The pattern is either a full message name, for example "cosmos.gov.v1.MsgVote", or a package
+// prefix ending with ".*", for example "cosmos.gov.*".
+type AnyTypeURLRule struct {
+	Pattern string
+	Allow   bool
+}
+
+// Matches returns true when the given type url is covered by the rule pattern
+func (r AnyTypeURLRule) Matches(typeURL string) bool {
+	name := strings.TrimPrefix(typeURL, "/")
+	if pkg, ok := strings.CutSuffix(r.Pattern, "*"); ok {
+		return strings.HasPrefix(name, pkg)
+	}
+	return name == r.Pattern
+}
+
+// EncodeAnyMsgWithTypeURLRules is an opt-in any encoder that rejects messages by type url. The first matching rule
+// decides. Messages not matching any rule are passed to the given encoder.
+// This can be used to force contracts to use the typed encoders, for example for gov messages:
+//
+//	EncodeAnyMsgWithTypeURLRules(EncodeAnyMsg(unpacker), AnyTypeURLRule{Pattern: "cosmos.gov.*"})
+func EncodeAnyMsgWithTypeURLRules(encoder AnyEncoder, rules ...AnyTypeURLRule) AnyEncoder {
+	return func(ctx sdk.Context, sender sdk.AccAddress, msg *wasmvmtypes.AnyMsg) ([]sdk.Msg, error) {
+		for _, r := range rules {
+			if !r.Matches(msg.TypeURL) {
+				continue
+			}
+			if !r.Allow {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "type url not allowed via any message: %s", msg.TypeURL)
+			}
+			break
+		}
+		return encoder(ctx, sender, msg)
+	}
+}
+
 func EncodeWasmMsg(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.Execute != nil:
@@ -286,6 +1002,8 @@ func EncodeWasmMsg(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg,
 		}
 		return []sdk.Msg{&sdkMsg}, nil
 	case msg.Migrate != nil:
+		// a migration can not transfer funds. The wasmvm MigrateMsg has no funds field,
+		// so there is nothing to reject here. Funds must be sent with a separate message.
 		sdkMsg := types.MsgMigrateContract{
 			Sender:   sender.String(),
 			Contract: msg.Migrate.ContractAddr,
@@ -311,16 +1029,42 @@ func EncodeWasmMsg(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg,
 	}
 }
 
+// EncodeWasmMsgWithBlockedAdmins is an opt-in wasm encoder that rejects an instantiate with an admin
+// for which the given predicate returns true, for example a module account.
+// All other messages are passed to the given encoder.
+func EncodeWasmMsgWithBlockedAdmins(encoder WasmEncoder, isBlocked func(admin string) bool) WasmEncoder {
+	return func(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error) {
+		var admin string
+		switch {
+		case msg.Instantiate != nil:
+			admin = msg.Instantiate.Admin
+		case msg.Instantiate2 != nil:
+			admin = msg.Instantiate2.Admin
+		}
+		if admin != "" && isBlocked(admin) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "blocked admin: %s", admin)
+		}
+		return encoder(sender, msg)
+	}
+}
+
 func EncodeIBCMsg(portSource types.ICS20TransferPortSource) func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error) {
+	return encodeIBCMsg(portSource, PortIDForContract)
+}
+
+// encodeIBCMsg encodes the ibc messages with the port ids of the contracts derived by the given function
+func encodeIBCMsg(portSource types.ICS20TransferPortSource, portIDForContract func(sdk.AccAddress) string) IBCEncoder {
 	return func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error) {
 		switch {
 		case msg.CloseChannel != nil:
 			return []sdk.Msg{&channeltypes.MsgChannelCloseInit{
-				PortId:    PortIDForContract(sender),
+				PortId:    portIDForContract(sender),
 				ChannelId: msg.CloseChannel.ChannelID,
 				Signer:    sender.String(),
 			}}, nil
 		case msg.Transfer != nil:
+			// a transfer carries a single token only. wasmvm has no tokens array and the multi token
+			// MsgTransfer of ics20 v2 is not available in ibc-go v10.
 			amount, err := ConvertWasmCoinToSdkCoin(msg.Transfer.Amount)
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "amount")
@@ -335,6 +1079,16 @@ func EncodeIBCMsg(portSource types.ICS20TransferPortSource) func(ctx sdk.Context
 				TimeoutTimestamp: msg.Transfer.Timeout.Timestamp,
 				Memo:             msg.Transfer.Memo,
 			}
+			// the event manager is not set on contexts that are only used for encoding
+			if em := ctx.EventManager(); em != nil {
+				em.EmitEvent(sdk.NewEvent(
+					types.EventTypeIBCTransfer,
+					sdk.NewAttribute(types.AttributeKeyChannel, msg.SourceChannel),
+					sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+					sdk.NewAttribute(types.AttributeKeyAmount, msg.Token.String()),
+					sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+				))
+			}
 			return []sdk.Msg{msg}, nil
 		case msg.PayPacketFee != nil:
 			return nil, errorsmod.Wrap(types.ErrUnknownMsg, "pay packet fee not supported")
@@ -346,6 +1100,64 @@ func EncodeIBCMsg(portSource types.ICS20TransferPortSource) func(ctx sdk.Context
 	}
 }
 
+// TransferWithRelativeTimeoutMsg describes an ics20 transfer by a contract with a timeout in seconds relative to the
+// current block time
+type TransferWithRelativeTimeoutMsg struct {
+	ChannelID      string           `json:"channel_id"`
+	ToAddress      string           `json:"to_address"`
+	Amount         wasmvmtypes.Coin `json:"amount"`
+	TimeoutSeconds uint64           `json:"timeout_seconds"`
+	Memo           string           `json:"memo,omitempty"`
+}
+
+// EncodeTransferWithRelativeTimeout is a helper for custom encoders to send an ics20 transfer with the sender as
+// token owner. The absolute timeout timestamp is computed from the block time of the given context.
+func EncodeTransferWithRelativeTimeout(ctx sdk.Context, portSource types.ICS20TransferPortSource, sender sdk.AccAddress, msg *TransferWithRelativeTimeoutMsg) ([]sdk.Msg, error) {
+	if msg.TimeoutSeconds == 0 {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "timeout seconds")
+	}
+	if msg.TimeoutSeconds > math.MaxInt64/uint64(time.Second) {
+		return nil, errorsmod.Wrapf(types.ErrLimit, "timeout seconds: %d", msg.TimeoutSeconds)
+	}
+	timeout := ctx.BlockTime().Add(time.Duration(msg.TimeoutSeconds) * time.Second)
+	return EncodeIBCMsg(portSource)(ctx, sender, "", &wasmvmtypes.IBCMsg{
+		Transfer: &wasmvmtypes.TransferMsg{
+			ChannelID: msg.ChannelID,
+			ToAddress: msg.ToAddress,
+			Amount:    msg.Amount,
+			Timeout:   wasmvmtypes.IBCTimeout{Timestamp: uint64(timeout.UnixNano())},
+			Memo:      msg.Memo,
+		},
+	})
+}
+
+// CloseChannelConfirmMsg describes the confirmation of a channel close that was initiated by the counterparty
+type CloseChannelConfirmMsg struct {
+	ChannelID string `json:"channel_id"`
+	// ProofInit is the proof of the closed channel end on the counterparty chain
+	ProofInit []byte `json:"proof_init"`
+	// ProofHeight is the counterparty height of the proof
+	ProofHeight wasmvmtypes.IBCTimeoutBlock `json:"proof_height"`
+}
+
+// EncodeCloseChannelConfirm is a helper for custom encoders to confirm a channel close on the port of the sender
+// contract. The proof fields must be set.
+func EncodeCloseChannelConfirm(sender sdk.AccAddress, msg *CloseChannelConfirmMsg) ([]sdk.Msg, error) {
+	if len(msg.ProofInit) == 0 {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "proof init")
+	}
+	if msg.ProofHeight.Height == 0 {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "proof height")
+	}
+	return []sdk.Msg{channeltypes.NewMsgChannelCloseConfirm(
+		PortIDForContract(sender),
+		msg.ChannelID,
+		msg.ProofInit,
+		ibcclienttypes.NewHeight(msg.ProofHeight.Revision, msg.ProofHeight.Height),
+		sender.String(),
+	)}, nil
+}
+
 func EncodeIBCv2Msg(sender sdk.AccAddress, msg *wasmvmtypes.IBC2Msg) ([]sdk.Msg, error) {
 	switch {
 	case msg.SendPacket != nil:
@@ -366,11 +1178,73 @@ func EncodeIBCv2Msg(sender sdk.AccAddress, msg *wasmvmtypes.IBC2Msg) ([]sdk.Msg,
 			Signer:           sender.String(),
 		}
 		return []sdk.Msg{msg}, nil
+	case msg.WriteAcknowledgement != nil:
+		// there is no sdk message for async acks in ibc v2. The ack is written via the channel keeper
+		// by the IBC2WriteAckHandler so that only the conversion is checked here.
+		if _, _, err := ConvertIBC2Acknowledgement(msg.WriteAcknowledgement.Ack); err != nil {
+			return nil, errorsmod.Wrap(err, "acknowledgement")
+		}
+		return nil, errorsmod.Wrap(types.ErrUnknownMsg, "write acknowledgement requires the IBC2WriteAckHandler")
 	default:
 		return nil, errorsmod.Wrap(types.ErrUnknownMsg, "unknown variant of IBCv2")
 	}
 }
 
+// DefaultIBC2PayloadEncodings are the IBCv2 payload encodings supported by ibc-go
+var DefaultIBC2PayloadEncodings = []string{
+	ibctransfertypes.EncodingJSON,
+	ibctransfertypes.EncodingProtobuf,
+	ibctransfertypes.EncodingABI,
+}
+
+// EncodeIBCv2MsgWithEncodingAllowList is an opt-in IBCv2 encoder that rejects a send packet with a payload
+// encoding that is empty or not in the allowed list, for example DefaultIBC2PayloadEncodings. The default
+// encoders copy the encoding verbatim. All other messages are passed to the given encoder.
+func EncodeIBCv2MsgWithEncodingAllowList(encoder IBC2Encoder, allowed ...string) IBC2Encoder {
+	allowList := make(map[string]struct{}, len(allowed))
+	for _, e := range allowed {
+		allowList[e] = struct{}{}
+	}
+	return func(sender sdk.AccAddress, msg *wasmvmtypes.IBC2Msg) ([]sdk.Msg, error) {
+		if msg.SendPacket != nil {
+			for i, p := range msg.SendPacket.Payloads {
+				if p.Encoding == "" {
+					return nil, errorsmod.Wrapf(types.ErrEmpty, "payload %d: encoding", i)
+				}
+				if _, ok := allowList[p.Encoding]; !ok {
+					return nil, errorsmod.Wrapf(types.ErrInvalidMsg, "payload %d: unsupported encoding: %s", i, p.Encoding)
+				}
+			}
+		}
+		return encoder(sender, msg)
+	}
+}
+
+// ConvertIBC2Acknowledgement converts the acknowledgement of an IBCv2 write ack message into the
+// channel v2 representation and returns true for a success ack. The wasmvm ack carries a single data field,
+// so an error ack is signaled by the contract either with the universal error acknowledgement bytes or
+// with a json object that has the `error` field set. Exactly one of `result` and `error` must be set on
+// such an object. Any other non-empty data is a success ack.
+func ConvertIBC2Acknowledgement(ack wasmvmtypes.IBCAcknowledgement) (channeltypesv2.Acknowledgement, bool, error) {
+	data := ack.Data
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(ack.Data, &fields); err == nil {
+		_, hasResult := fields["result"]
+		_, hasError := fields["error"]
+		switch {
+		case hasResult && hasError:
+			return channeltypesv2.Acknowledgement{}, false, errorsmod.Wrap(channeltypesv2.ErrInvalidAcknowledgement, "both result and error set")
+		case hasError:
+			data = channeltypesv2.ErrorAcknowledgement[:]
+		}
+	}
+	result := channeltypesv2.NewAcknowledgement(data)
+	if err := result.Validate(); err != nil {
+		return channeltypesv2.Acknowledgement{}, false, err
+	}
+	return result, result.Success(), nil
+}
+
 func EncodeGovMsg(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.Vote != nil:
@@ -382,6 +1256,7 @@ func EncodeGovMsg(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, er
 		return []sdk.Msg{m}, nil
 	case msg.VoteWeighted != nil:
 		opts := make([]*v1.WeightedVoteOption, len(msg.VoteWeighted.Options))
+		seen := make(map[v1.VoteOption]struct{}, len(msg.VoteWeighted.Options))
 		for i, v := range msg.VoteWeighted.Options {
 			weight, err := sdkmath.LegacyNewDecFromStr(v.Weight)
 			if err != nil {
@@ -391,6 +1266,10 @@ func EncodeGovMsg(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, er
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "vote option")
 			}
+			if _, ok := seen[voteOption]; ok {
+				return nil, errorsmod.Wrapf(types.ErrDuplicate, "vote option %s", voteOption)
+			}
+			seen[voteOption] = struct{}{}
 			opts[i] = &v1.WeightedVoteOption{Option: voteOption, Weight: weight.String()}
 		}
 		m := v1.NewMsgVoteWeighted(sender, msg.VoteWeighted.ProposalId, opts, "")
@@ -401,6 +1280,130 @@ func EncodeGovMsg(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, er
 	}
 }
 
+// EncodeAbstainVote returns a weighted vote message with the full weight on abstain for the given proposal
+func EncodeAbstainVote(sender sdk.AccAddress, proposalID uint64) ([]sdk.Msg, error) {
+	return EncodeGovMsg(sender, &wasmvmtypes.GovMsg{
+		VoteWeighted: &wasmvmtypes.VoteWeightedMsg{
+			ProposalId: proposalID,
+			Options:    []wasmvmtypes.WeightedVoteOption{{Option: wasmvmtypes.Abstain, Weight: "1"}},
+		},
+	})
+}
+
+// SubmitProposalMsg describes a gov proposal submitted by a contract. The variant is not part of the
+// wasmvm gov messages so that it has to be sent as a custom message and encoded via EncodeSubmitProposal.
+type SubmitProposalMsg struct {
+	Messages       []wasmvmtypes.AnyMsg                `json:"messages"`
+	InitialDeposit wasmvmtypes.Array[wasmvmtypes.Coin] `json:"initial_deposit"`
+	Metadata       string                              `json:"metadata"`
+	Title          string                              `json:"title"`
+	Summary        string                              `json:"summary"`
+	Expedited      bool                                `json:"expedited"`
+}
+
+// EncodeSubmitProposal is a helper for custom encoders to submit a gov proposal with the sender as proposer.
+// The contained messages are unpacked with the given unpacker so that only registered types are accepted.
+func EncodeSubmitProposal(unpacker codectypes.AnyUnpacker, sender sdk.AccAddress, msg *SubmitProposalMsg) ([]sdk.Msg, error) {
+	if len(msg.Messages) == 0 && msg.Metadata == "" {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "proposal messages or metadata")
+	}
+	proposalMsgs, err := unpackAnyMsgs(unpacker, msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := ConvertWasmCoinsToSdkCoins(msg.InitialDeposit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "initial deposit")
+	}
+	m, err := v1.NewMsgSubmitProposal(proposalMsgs, deposit, sender.String(), msg.Metadata, msg.Title, msg.Summary, msg.Expedited)
+	if err != nil {
+		return nil, err
+	}
+	return []sdk.Msg{m}, nil
+}
+
+// CommunityPoolSpendProposalMsg describes a gov proposal by a contract to spend funds from the community pool
+type CommunityPoolSpendProposalMsg struct {
+	Recipient      string                              `json:"recipient"`
+	Amount         wasmvmtypes.Array[wasmvmtypes.Coin] `json:"amount"`
+	InitialDeposit wasmvmtypes.Array[wasmvmtypes.Coin] `json:"initial_deposit"`
+	Metadata       string                              `json:"metadata"`
+	Title          string                              `json:"title"`
+	Summary        string                              `json:"summary"`
+	Expedited      bool                                `json:"expedited"`
+}
+
+// EncodeCommunityPoolSpendProposal is a helper for custom encoders to submit a gov proposal with the sender as
+// proposer that contains a community pool spend. The spend is executed by the given gov authority when the
+// proposal passes.
+func EncodeCommunityPoolSpendProposal(govAuthority string, sender sdk.AccAddress, msg *CommunityPoolSpendProposalMsg) ([]sdk.Msg, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient)
+	}
+	amount, err := ConvertWasmCoinsToSdkCoins(msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "amount")
+	}
+	if amount.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "amount")
+	}
+	deposit, err := ConvertWasmCoinsToSdkCoins(msg.InitialDeposit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "initial deposit")
+	}
+	spend := &distributiontypes.MsgCommunityPoolSpend{
+		Authority: govAuthority,
+		Recipient: msg.Recipient,
+		Amount:    amount,
+	}
+	m, err := v1.NewMsgSubmitProposal([]sdk.Msg{spend}, deposit, sender.String(), msg.Metadata, msg.Title, msg.Summary, msg.Expedited)
+	if err != nil {
+		return nil, err
+	}
+	return []sdk.Msg{m}, nil
+}
+
+// AuthzExecMsg describes sdk messages executed by a contract under authz grants given to it
+type AuthzExecMsg struct {
+	Grantee  string               `json:"grantee"`
+	Messages []wasmvmtypes.AnyMsg `json:"messages"`
+}
+
+// EncodeAuthzExec is a helper for custom encoders to wrap the given messages into an authz exec message.
+// The grantee must be the sending contract. The contained messages are unpacked with the given unpacker
+// so that only registered types are accepted.
+func EncodeAuthzExec(unpacker codectypes.AnyUnpacker, sender sdk.AccAddress, msg *AuthzExecMsg) ([]sdk.Msg, error) {
+	if msg.Grantee != sender.String() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "grantee %s is not the contract", msg.Grantee)
+	}
+	if len(msg.Messages) == 0 {
+		return nil, errorsmod.Wrap(types.ErrEmpty, "exec messages")
+	}
+	execMsgs, err := unpackAnyMsgs(unpacker, msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+	m := authz.NewMsgExec(sender, execMsgs)
+	return []sdk.Msg{&m}, nil
+}
+
+func unpackAnyMsgs(unpacker codectypes.AnyUnpacker, msgs []wasmvmtypes.AnyMsg) ([]sdk.Msg, error) {
+	sdkMsgs := make([]sdk.Msg, len(msgs))
+	for i, m := range msgs {
+		codecAny := codectypes.Any{
+			TypeUrl: m.TypeURL,
+			Value:   m.Value,
+		}
+		if err := unpacker.UnpackAny(&codecAny, &sdkMsgs[i]); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidMsg, fmt.Sprintf("Cannot unpack proto message with type URL: %s", m.TypeURL))
+		}
+		if err := codectypes.UnpackInterfaces(sdkMsgs[i], unpacker); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidMsg, fmt.Sprintf("UnpackInterfaces inside msg: %s", err))
+		}
+	}
+	return sdkMsgs, nil
+}
+
 func convertVoteOption(s interface{}) (v1.VoteOption, error) {
 	var option v1.VoteOption
 	switch s {
@@ -426,17 +1429,62 @@ func ConvertWasmIBCTimeoutHeightToCosmosHeight(ibcTimeoutBlock *wasmvmtypes.IBCT
 	return ibcclienttypes.NewHeight(ibcTimeoutBlock.Revision, ibcTimeoutBlock.Height)
 }
 
+// convertDisplayCoinToBaseUnits scales the decimal amount of a coin in display units by the exponent of the
+// display unit and returns the coin in the base denom. Amounts that do not fit into base units are rejected.
+func convertDisplayCoinToBaseUnits(coin wasmvmtypes.Coin, metadata banktypes.Metadata) (wasmvmtypes.Coin, error) {
+	var unit *banktypes.DenomUnit
+	for _, u := range metadata.DenomUnits {
+		if u != nil && u.Denom == coin.Denom {
+			unit = u
+			break
+		}
+	}
+	if unit == nil {
+		return wasmvmtypes.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "no denom unit %s in metadata of %s", coin.Denom, metadata.Base)
+	}
+	amount, err := sdkmath.LegacyNewDecFromStr(coin.Amount)
+	if err != nil {
+		return wasmvmtypes.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coin.Amount+coin.Denom)
+	}
+	// the product is bound by the bit lengths of the factors and must fit into an sdk Int
+	multiplier := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(unit.Exponent)), nil)
+	if amount.TruncateInt().BigInt().BitLen()+multiplier.BitLen() > sdkmath.MaxBitLen {
+		return wasmvmtypes.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s%s too large", coin.Amount, coin.Denom)
+	}
+	baseAmount := amount.MulInt(sdkmath.NewIntFromBigInt(multiplier))
+	if !baseAmount.IsInteger() {
+		return wasmvmtypes.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s%s exceeds precision of %s", coin.Amount, coin.Denom, metadata.Base)
+	}
+	return wasmvmtypes.Coin{Denom: metadata.Base, Amount: baseAmount.TruncateInt().String()}, nil
+}
+
 // ConvertWasmCoinsToSdkCoins converts the wasm vm type coins to sdk type coins
+// Zero amounts are dropped and duplicate denoms merged.
 func ConvertWasmCoinsToSdkCoins(coins []wasmvmtypes.Coin) (sdk.Coins, error) {
-	var toSend sdk.Coins
+	if len(coins) == 0 {
+		return nil, nil
+	}
+	toSend := make(sdk.Coins, 0, len(coins))
 	for _, coin := range coins {
 		c, err := ConvertWasmCoinToSdkCoin(coin)
 		if err != nil {
 			return nil, err
 		}
-		toSend = toSend.Add(c)
+		if !c.IsZero() {
+			toSend = append(toSend, c)
+		}
+	}
+	// sort once and merge duplicates in a single pass instead of adding coin by coin
+	sort.Slice(toSend, func(i, j int) bool { return toSend[i].Denom < toSend[j].Denom })
+	merged := toSend[:0]
+	for _, c := range toSend {
+		if last := len(merged) - 1; last >= 0 && merged[last].Denom == c.Denom {
+			merged[last].Amount = merged[last].Amount.Add(c.Amount)
+			continue
+		}
+		merged = append(merged, c)
 	}
-	return toSend.Sort(), nil
+	return merged, nil
 }
 
 // ConvertWasmCoinToSdkCoin converts a wasm vm type coin to sdk type coin
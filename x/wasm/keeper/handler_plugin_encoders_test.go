@@ -1,7 +1,14 @@
 package keeper
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -9,14 +16,23 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types" //nolint:staticcheck
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/hashicorp/go-metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -678,6 +694,237 @@ func TestEncodeIbcMsg(t *testing.T) {
 	}
 }
 
+func TestEncodeIBCMsgEmitsTransferEvent(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	em := sdk.NewEventManager()
+	ctx := sdk.Context{}.WithEventManager(em)
+	encoder := EncodeIBCMsg(wasmtesting.MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}})
+
+	// when
+	gotMsgs, gotErr := encoder(ctx, myAddr, "", &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{
+		ChannelID: "channel-1",
+		ToAddress: "osmo1pkptre7fdkl6gfrzlesjjvhxhlc3r4gmmk8rs6",
+		Amount:    wasmvmtypes.NewCoin(1, "denom"),
+		Timeout:   wasmvmtypes.IBCTimeout{Timestamp: 100},
+	}})
+
+	// then
+	require.NoError(t, gotErr)
+	require.Len(t, gotMsgs, 1)
+	gotMsg := gotMsgs[0].(*ibctransfertypes.MsgTransfer)
+	exp := sdk.Events{sdk.NewEvent("wasm_ibc_transfer",
+		sdk.NewAttribute("channel", gotMsg.SourceChannel),
+		sdk.NewAttribute("receiver", gotMsg.Receiver),
+		sdk.NewAttribute("amount", gotMsg.Token.String()),
+		sdk.NewAttribute("sender", gotMsg.Sender),
+	)}
+	assert.Equal(t, exp, em.Events())
+	assert.Equal(t, "1denom", gotMsg.Token.String())
+	assert.Equal(t, myAddr.String(), gotMsg.Sender)
+
+	// and not for other variants
+	em = sdk.NewEventManager()
+	_, gotErr = encoder(ctx.WithEventManager(em), myAddr, "", &wasmvmtypes.IBCMsg{CloseChannel: &wasmvmtypes.CloseChannelMsg{ChannelID: "channel-1"}})
+	require.NoError(t, gotErr)
+	assert.Empty(t, em.Events())
+
+	// and a context without event manager is supported
+	_, gotErr = encoder(sdk.Context{}, myAddr, "", &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{
+		ChannelID: "channel-1",
+		ToAddress: "osmo1pkptre7fdkl6gfrzlesjjvhxhlc3r4gmmk8rs6",
+		Amount:    wasmvmtypes.NewCoin(1, "denom"),
+		Timeout:   wasmvmtypes.IBCTimeout{Timestamp: 100},
+	}})
+	require.NoError(t, gotErr)
+}
+
+func TestEncodeBudget(t *testing.T) {
+	var (
+		myAddr       = RandomAccountAddress(t)
+		addr1        = RandomBech32AccountAddress(t)
+		contractAddr = RandomBech32AccountAddress(t)
+		budget       = EncodeBudget{MaxCoins: 2, MaxNesting: 2, MaxAnyValueSize: 128}
+	)
+	coins := func(n int) wasmvmtypes.Array[wasmvmtypes.Coin] {
+		r := make(wasmvmtypes.Array[wasmvmtypes.Coin], n)
+		for i := range r {
+			r[i] = wasmvmtypes.NewCoin(1, fmt.Sprintf("denom%d", i))
+		}
+		return r
+	}
+	sendMsg := func(amount wasmvmtypes.Array[wasmvmtypes.Coin]) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: addr1, Amount: amount}}}
+	}
+	executeMsg := func(msg string, funds wasmvmtypes.Array[wasmvmtypes.Coin]) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: contractAddr, Msg: []byte(msg), Funds: funds}}}
+	}
+	bankMsgBz, err := proto.Marshal(&banktypes.MsgSend{FromAddress: myAddr.String(), ToAddress: addr1, Amount: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))})
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(bankMsgBz), budget.MaxAnyValueSize)
+
+	specs := map[string]struct {
+		msg    wasmvmtypes.CosmosMsg
+		expErr *errorsmod.Error
+	}{
+		"coins within budget": {
+			msg: sendMsg(coins(2)),
+		},
+		"coins exceed budget": {
+			msg:    sendMsg(coins(3)),
+			expErr: types.ErrLimit,
+		},
+		"funds exceed budget": {
+			msg:    executeMsg(`{}`, coins(3)),
+			expErr: types.ErrLimit,
+		},
+		"nesting within budget": {
+			msg: executeMsg(`{"foo":{"bar":"{[{["}}`, nil),
+		},
+		"nesting exceeds budget": {
+			msg:    executeMsg(`{"foo":{"bar":[1]}}`, nil),
+			expErr: types.ErrLimit,
+		},
+		"custom nesting exceeds budget": {
+			msg:    wasmvmtypes.CosmosMsg{Custom: []byte(`[[[]]]`)},
+			expErr: types.ErrLimit,
+		},
+		"any within budget": {
+			msg: wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: "/cosmos.bank.v1beta1.MsgSend", Value: bankMsgBz}},
+		},
+		"any exceeds budget": {
+			msg:    wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: "/cosmos.bank.v1beta1.MsgSend", Value: make([]byte, budget.MaxAnyValueSize+1)}},
+			expErr: types.ErrLimit,
+		},
+	}
+	encoder := DefaultEncoders(MakeEncodingConfig(t).Codec, nil).Merge(&MessageEncoders{Budget: &budget})
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			// when
+			_, gotErr := encoder.Encode(ctx, myAddr, "", spec.msg)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestEncodeIncrementsTelemetryCounter(t *testing.T) {
+	// go-metrics has no setter for the global instance, so the previous one is restored as a pass-through sink
+	prevMetrics := metrics.Default()
+	t.Cleanup(func() { _, _ = metrics.NewGlobal(&metrics.Config{FilterDefault: true}, prevMetrics) })
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = telemetry.New(telemetry.Config{}) })
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	var (
+		myAddr  = RandomAccountAddress(t)
+		addr1   = RandomBech32AccountAddress(t)
+		valAddr = make(sdk.ValAddress, types.SDKAddrLen)
+	)
+	valAddr[0] = 12
+	bankMsg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+		ToAddress: addr1,
+		Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "alx")},
+	}}}
+	stakingMsg := wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{
+		Validator: valAddr.String(),
+		Amount:    wasmvmtypes.NewCoin(1, "stake"),
+	}}}
+	govMsg := wasmvmtypes.CosmosMsg{Gov: &wasmvmtypes.GovMsg{Vote: &wasmvmtypes.VoteMsg{ProposalId: 1, Option: wasmvmtypes.Yes}}}
+	invalidMsg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}}
+
+	encoder := DefaultEncoders(MakeEncodingConfig(t).Codec, nil)
+	var ctx sdk.Context
+	// when
+	for _, msg := range []wasmvmtypes.CosmosMsg{bankMsg, bankMsg, stakingMsg, govMsg, invalidMsg} {
+		_, _ = encoder.Encode(ctx, myAddr, "", msg)
+	}
+
+	// then
+	got := make(map[string]int)
+	for _, interval := range sink.Data() {
+		for _, c := range interval.Counters {
+			got[c.Name+";"+c.Labels[0].Value] += c.Count
+		}
+	}
+	assert.Equal(t, map[string]int{
+		"wasm.contract.msg.encoded;bank":    2,
+		"wasm.contract.msg.encoded;staking": 1,
+		"wasm.contract.msg.encoded;gov":     1,
+	}, got)
+}
+
+func TestEncodeEmitsEncodedMsgsEvent(t *testing.T) {
+	var (
+		myAddr = RandomAccountAddress(t)
+		addr1  = RandomBech32AccountAddress(t)
+	)
+	encodingConfig := MakeEncodingConfig(t)
+	specs := map[string]struct {
+		enabled   bool
+		srcMsg    wasmvmtypes.CosmosMsg
+		expEvents sdk.Events
+	}{
+		"disabled": {
+			srcMsg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+				ToAddress: addr1,
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "alx")},
+			}}},
+			expEvents: sdk.Events{},
+		},
+		"single message": {
+			enabled: true,
+			srcMsg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+				ToAddress: addr1,
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "alx")},
+			}}},
+			expEvents: sdk.Events{sdk.NewEvent("encoded_msgs",
+				sdk.NewAttribute("_contract_address", myAddr.String()),
+				sdk.NewAttribute("msg_count", "1"),
+				sdk.NewAttribute("msg_type_url", "/cosmos.bank.v1beta1.MsgSend"),
+			)},
+		},
+		"no message": {
+			enabled: true,
+			srcMsg:  wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: addr1}}},
+			expEvents: sdk.Events{sdk.NewEvent("encoded_msgs",
+				sdk.NewAttribute("_contract_address", myAddr.String()),
+				sdk.NewAttribute("msg_count", "0"),
+			)},
+		},
+		"encoding error": {
+			enabled:   true,
+			srcMsg:    wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}},
+			expEvents: sdk.Events{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			ctx := sdk.Context{}.WithEventManager(em)
+			encoder := DefaultEncoders(encodingConfig.Codec, nil).Merge(&MessageEncoders{EmitEncodedMsgsEvent: spec.enabled})
+
+			// when
+			_, _ = encoder.Encode(ctx, myAddr, "", spec.srcMsg)
+
+			// then
+			assert.Equal(t, spec.expEvents, em.Events())
+		})
+	}
+}
+
 func TestEncodeGovMsg(t *testing.T) {
 	myAddr := RandomAccountAddress(t)
 
@@ -809,15 +1056,15 @@ func TestEncodeGovMsg(t *testing.T) {
 				},
 			},
 		},
-		"Gov weighted vote: duplicate option - invalid": {
+		"Gov weighted vote: max precision": {
 			sender: myAddr,
 			srcMsg: wasmvmtypes.CosmosMsg{
 				Gov: &wasmvmtypes.GovMsg{
 					VoteWeighted: &wasmvmtypes.VoteWeightedMsg{
 						ProposalId: 1,
 						Options: []wasmvmtypes.WeightedVoteOption{
-							{Option: wasmvmtypes.Yes, Weight: "0.5"},
-							{Option: wasmvmtypes.Yes, Weight: "0.5"},
+							{Option: wasmvmtypes.Yes, Weight: "0.333333333333333333"},
+							{Option: wasmvmtypes.No, Weight: "0.666666666666666667"},
 						},
 					},
 				},
@@ -827,11 +1074,41 @@ func TestEncodeGovMsg(t *testing.T) {
 					ProposalId: 1,
 					Voter:      myAddr.String(),
 					Options: []*govv1.WeightedVoteOption{
-						{Option: govv1.OptionYes, Weight: sdkmath.LegacyNewDecWithPrec(5, 1).String()},
-						{Option: govv1.OptionYes, Weight: sdkmath.LegacyNewDecWithPrec(5, 1).String()},
+						{Option: govv1.OptionYes, Weight: sdkmath.LegacyNewDecWithPrec(333333333333333333, 18).String()},
+						{Option: govv1.OptionNo, Weight: sdkmath.LegacyNewDecWithPrec(666666666666666667, 18).String()},
+					},
+				},
+			},
+		},
+		"Gov weighted vote: over precision - invalid": {
+			sender: myAddr,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Gov: &wasmvmtypes.GovMsg{
+					VoteWeighted: &wasmvmtypes.VoteWeightedMsg{
+						ProposalId: 1,
+						Options: []wasmvmtypes.WeightedVoteOption{
+							{Option: wasmvmtypes.Yes, Weight: "0.3333333333333333333"},
+							{Option: wasmvmtypes.No, Weight: "0.6666666666666666667"},
+						},
+					},
+				},
+			},
+			expError: true,
+		},
+		"Gov weighted vote: duplicate option - invalid": {
+			sender: myAddr,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Gov: &wasmvmtypes.GovMsg{
+					VoteWeighted: &wasmvmtypes.VoteWeightedMsg{
+						ProposalId: 1,
+						Options: []wasmvmtypes.WeightedVoteOption{
+							{Option: wasmvmtypes.Yes, Weight: "0.5"},
+							{Option: wasmvmtypes.Yes, Weight: "0.5"},
+						},
 					},
 				},
 			},
+			expError: true,
 		},
 		"Gov weighted vote: weight sum exceeds 1- invalid": {
 			sender: myAddr,
@@ -898,182 +1175,1704 @@ func TestEncodeGovMsg(t *testing.T) {
 	}
 }
 
-func TestEncodeIBCv2Msg(t *testing.T) {
+func TestEncodeSubmitProposal(t *testing.T) {
 	var (
-		myAddr   = RandomAccountAddress(t)
-		destAddr = RandomAccountAddress(t)
+		myAddr  = RandomAccountAddress(t)
+		addr1   = RandomAccountAddress(t)
+		bankMsg = &banktypes.MsgSend{
+			FromAddress: myAddr.String(),
+			ToAddress:   addr1.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 12345)),
+		}
+		bankAnyMsg = wasmvmtypes.AnyMsg{TypeURL: sdk.MsgTypeURL(bankMsg), Value: must(proto.Marshal(bankMsg))}
 	)
-
-	cases := map[string]struct {
-		sender             sdk.AccAddress
-		srcMsg             wasmvmtypes.CosmosMsg
-		transferPortSource types.ICS20TransferPortSource
-		// set if valid
-		output []sdk.Msg
-		// set if expect mapping fails
-		expError bool
+	specs := map[string]struct {
+		msg     *SubmitProposalMsg
+		expMsgs []sdk.Msg
+		expErr  bool
 	}{
-		"IBC2 SendPacket": {
-			sender: myAddr,
-			srcMsg: wasmvmtypes.CosmosMsg{
-				IBC2: &wasmvmtypes.IBC2Msg{
-					SendPacket: &wasmvmtypes.IBC2SendPacketMsg{
-						SourceClient: myAddr.String(),
-						Payloads: []wasmvmtypes.IBC2Payload{
-							{
-								SourcePort:      PortIDForContractV2(myAddr),
-								DestinationPort: PortIDForContractV2(destAddr),
-								Version:         "v1",
-								Encoding:        "json",
-								Value:           []byte{},
-							},
-						},
-						Timeout: 1000000000000,
-					},
-				},
-			},
-			output: []sdk.Msg{
-				&channeltypesv2.MsgSendPacket{
-					SourceClient:     myAddr.String(),
-					TimeoutTimestamp: 1000,
-					Signer:           myAddr.String(),
-					Payloads: []channeltypesv2.Payload{
-						{
-							SourcePort:      PortIDForContractV2(myAddr),
-							DestinationPort: PortIDForContractV2(destAddr),
-							Version:         "v1",
-							Encoding:        "json",
-							Value:           []byte{},
-						},
-					},
-				},
+		"with messages and deposit": {
+			msg: &SubmitProposalMsg{
+				Messages:       []wasmvmtypes.AnyMsg{bankAnyMsg},
+				InitialDeposit: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "stake")},
+				Title:          "my title",
+				Summary:        "my summary",
+				Expedited:      true,
+			},
+			expMsgs: []sdk.Msg{must(govv1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), myAddr.String(), "", "my title", "my summary", true))},
+		},
+		"metadata only": {
+			msg:     &SubmitProposalMsg{Metadata: "ipfs://CID", Title: "my title", Summary: "my summary"},
+			expMsgs: []sdk.Msg{must(govv1.NewMsgSubmitProposal(nil, nil, myAddr.String(), "ipfs://CID", "my title", "my summary", false))},
+		},
+		"no messages and no metadata": {
+			msg:    &SubmitProposalMsg{Title: "my title", Summary: "my summary"},
+			expErr: true,
+		},
+		"unknown message type": {
+			msg:    &SubmitProposalMsg{Messages: []wasmvmtypes.AnyMsg{{TypeURL: "/foo.bar", Value: []byte{0x1}}}},
+			expErr: true,
+		},
+		"invalid deposit": {
+			msg: &SubmitProposalMsg{
+				Messages:       []wasmvmtypes.AnyMsg{bankAnyMsg},
+				InitialDeposit: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "!")},
 			},
+			expErr: true,
 		},
 	}
 	encodingConfig := MakeEncodingConfig(t)
-	for name, tc := range cases {
+	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			var ctx sdk.Context
-			encoder := DefaultEncoders(encodingConfig.Codec, tc.transferPortSource)
-			res, gotEncErr := encoder.Encode(ctx, tc.sender, "myIBCPort", tc.srcMsg)
-			if tc.expError {
-				assert.Error(t, gotEncErr)
+			gotMsgs, gotErr := EncodeSubmitProposal(encodingConfig.Codec, myAddr, spec.msg)
+			if spec.expErr {
+				assert.Error(t, gotErr)
 				return
 			}
-			require.NoError(t, gotEncErr)
-			assert.Equal(t, tc.output, res)
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
 		})
 	}
 }
 
-func TestConvertWasmCoinToSdkCoin(t *testing.T) {
+func TestEncodeCommunityPoolSpendProposal(t *testing.T) {
+	var (
+		myAddr       = RandomAccountAddress(t)
+		recipient    = RandomBech32AccountAddress(t)
+		govAuthority = authtypes.NewModuleAddress("gov").String()
+	)
 	specs := map[string]struct {
-		src    wasmvmtypes.Coin
-		expErr bool
-		expVal sdk.Coin
+		msg     *CommunityPoolSpendProposalMsg
+		expMsgs []sdk.Msg
+		expErr  bool
 	}{
-		"all good": {
-			src: wasmvmtypes.Coin{
-				Denom:  "foo",
-				Amount: "1",
-			},
-			expVal: sdk.NewCoin("foo", sdkmath.NewIntFromUint64(1)),
+		"valid spend": {
+			msg: &CommunityPoolSpendProposalMsg{
+				Recipient:      recipient,
+				Amount:         wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1000, "stake")},
+				InitialDeposit: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "stake")},
+				Title:          "my title",
+				Summary:        "my summary",
+			},
+			expMsgs: []sdk.Msg{must(govv1.NewMsgSubmitProposal(
+				[]sdk.Msg{&distributiontypes.MsgCommunityPoolSpend{
+					Authority: govAuthority,
+					Recipient: recipient,
+					Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+				}},
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), myAddr.String(), "", "my title", "my summary", false))},
 		},
-		"negative amount": {
-			src: wasmvmtypes.Coin{
-				Denom:  "foo",
-				Amount: "-1",
-			},
+		"empty amount": {
+			msg:    &CommunityPoolSpendProposalMsg{Recipient: recipient},
 			expErr: true,
 		},
-		"denom too short": {
-			src: wasmvmtypes.Coin{
-				Denom:  "f",
-				Amount: "1",
-			},
+		"zero amount": {
+			msg:    &CommunityPoolSpendProposalMsg{Recipient: recipient, Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(0, "stake")}},
 			expErr: true,
 		},
-		"invalid denom char": {
-			src: wasmvmtypes.Coin{
-				Denom:  "&fff",
-				Amount: "1",
-			},
+		"invalid amount denom": {
+			msg:    &CommunityPoolSpendProposalMsg{Recipient: recipient, Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "!")}},
 			expErr: true,
 		},
-		"not a number amount": {
-			src: wasmvmtypes.Coin{
-				Denom:  "foo",
-				Amount: "bar",
-			},
+		"invalid recipient": {
+			msg:    &CommunityPoolSpendProposalMsg{Recipient: "invalid", Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "stake")}},
 			expErr: true,
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			gotVal, gotErr := ConvertWasmCoinToSdkCoin(spec.src)
+			gotMsgs, gotErr := EncodeCommunityPoolSpendProposal(govAuthority, myAddr, spec.msg)
 			if spec.expErr {
-				require.Error(t, gotErr)
+				assert.Error(t, gotErr)
 				return
 			}
 			require.NoError(t, gotErr)
-			assert.Equal(t, spec.expVal, gotVal)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
 		})
 	}
 }
 
-func TestConvertWasmCoinsToSdkCoins(t *testing.T) {
+func TestEncodeAuthzExec(t *testing.T) {
+	var (
+		myAddr  = RandomAccountAddress(t)
+		addr1   = RandomAccountAddress(t)
+		granter = RandomAccountAddress(t)
+		bankMsg = &banktypes.MsgSend{
+			FromAddress: granter.String(),
+			ToAddress:   addr1.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 12345)),
+		}
+		bankAnyMsg = wasmvmtypes.AnyMsg{TypeURL: sdk.MsgTypeURL(bankMsg), Value: must(proto.Marshal(bankMsg))}
+	)
+	execMsg := authz.NewMsgExec(myAddr, []sdk.Msg{bankMsg})
 	specs := map[string]struct {
-		src    []wasmvmtypes.Coin
-		exp    sdk.Coins
-		expErr bool
+		msg     *AuthzExecMsg
+		expMsgs []sdk.Msg
+		expErr  error
 	}{
-		"empty": {
-			src: []wasmvmtypes.Coin{},
-			exp: nil,
+		"with messages": {
+			msg:     &AuthzExecMsg{Grantee: myAddr.String(), Messages: []wasmvmtypes.AnyMsg{bankAnyMsg}},
+			expMsgs: []sdk.Msg{&execMsg},
 		},
-		"single coin": {
-			src: []wasmvmtypes.Coin{{Denom: "foo", Amount: "1"}},
-			exp: sdk.NewCoins(sdk.NewCoin("foo", sdkmath.NewInt(1))),
+		"empty messages": {
+			msg:    &AuthzExecMsg{Grantee: myAddr.String()},
+			expErr: types.ErrEmpty,
 		},
-		"multiple coins": {
-			src: []wasmvmtypes.Coin{
-				{Denom: "foo", Amount: "1"},
-				{Denom: "bar", Amount: "2"},
-			},
-			exp: sdk.NewCoins(
-				sdk.NewCoin("bar", sdkmath.NewInt(2)),
-				sdk.NewCoin("foo", sdkmath.NewInt(1)),
-			),
+		"grantee not the contract": {
+			msg:    &AuthzExecMsg{Grantee: addr1.String(), Messages: []wasmvmtypes.AnyMsg{bankAnyMsg}},
+			expErr: sdkerrors.ErrUnauthorized,
 		},
-		"sorted": {
-			src: []wasmvmtypes.Coin{
-				{Denom: "foo", Amount: "1"},
-				{Denom: "other", Amount: "1"},
-				{Denom: "bar", Amount: "1"},
-			},
-			exp: []sdk.Coin{
-				sdk.NewCoin("bar", sdkmath.NewInt(1)),
-				sdk.NewCoin("foo", sdkmath.NewInt(1)),
-				sdk.NewCoin("other", sdkmath.NewInt(1)),
-			},
+		"unknown message type": {
+			msg:    &AuthzExecMsg{Grantee: myAddr.String(), Messages: []wasmvmtypes.AnyMsg{{TypeURL: "/foo.bar", Value: []byte{0x1}}}},
+			expErr: types.ErrInvalidMsg,
 		},
-		"zero amounts dropped": {
-			src: []wasmvmtypes.Coin{
-				{Denom: "foo", Amount: "1"},
-				{Denom: "bar", Amount: "0"},
-			},
-			exp: sdk.NewCoins(
-				sdk.NewCoin("foo", sdkmath.NewInt(1)),
-			),
+	}
+	encodingConfig := MakeEncodingConfig(t)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := EncodeAuthzExec(encodingConfig.Codec, myAddr, spec.msg)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeBankMsgRejectEmptySend(t *testing.T) {
+	var (
+		myAddr    = RandomAccountAddress(t)
+		addr1     = RandomAccountAddress(t)
+		emptySend = &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: addr1.String()}}
+	)
+	specs := map[string]struct {
+		encoder BankEncoder
+		msg     *wasmvmtypes.BankMsg
+		expMsgs []sdk.Msg
+		expErr  error
+	}{
+		"default - empty amount dropped": {
+			encoder: EncodeBankMsg,
+			msg:     emptySend,
 		},
-		"duplicate denoms merged": {
-			src: []wasmvmtypes.Coin{
-				{Denom: "foo", Amount: "1"},
-				{Denom: "foo", Amount: "1"},
-			},
-			exp: []sdk.Coin{sdk.NewCoin("foo", sdkmath.NewInt(2))},
+		"reject empty - empty amount": {
+			encoder: EncodeBankMsgRejectEmptySend(EncodeBankMsg),
+			msg:     emptySend,
+			expErr:  types.ErrEmpty,
 		},
-		"duplicate denoms with one 0 amount does not fail": {
+		"reject empty - with amount": {
+			encoder: EncodeBankMsgRejectEmptySend(EncodeBankMsg),
+			msg: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+				ToAddress: addr1.String(),
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "alx")},
+			}},
+			expMsgs: []sdk.Msg{&banktypes.MsgSend{
+				FromAddress: myAddr.String(),
+				ToAddress:   addr1.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("alx", 1)),
+			}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := spec.encoder(myAddr, spec.msg)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeBankMsgWithSelfSendCheck(t *testing.T) {
+	var (
+		myAddr   = RandomAccountAddress(t)
+		addr1    = RandomAccountAddress(t)
+		coins    = wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "stake")}
+		selfSend = &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: myAddr.String(), Amount: coins}}
+		send     = &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: addr1.String(), Amount: coins}}
+	)
+	specs := map[string]struct {
+		encoder BankEncoder
+		msg     *wasmvmtypes.BankMsg
+		expMsgs []sdk.Msg
+		expErr  error
+	}{
+		"self send - default": {
+			encoder: EncodeBankMsg,
+			msg:     selfSend,
+			expMsgs: []sdk.Msg{&banktypes.MsgSend{FromAddress: myAddr.String(), ToAddress: myAddr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}},
+		},
+		"self send - skip": {
+			encoder: EncodeBankMsgWithSelfSendCheck(EncodeBankMsg, false),
+			msg:     selfSend,
+		},
+		"self send - reject": {
+			encoder: EncodeBankMsgWithSelfSendCheck(EncodeBankMsg, true),
+			msg:     selfSend,
+			expErr:  types.ErrInvalid,
+		},
+		"other recipient - skip": {
+			encoder: EncodeBankMsgWithSelfSendCheck(EncodeBankMsg, false),
+			msg:     send,
+			expMsgs: []sdk.Msg{&banktypes.MsgSend{FromAddress: myAddr.String(), ToAddress: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}},
+		},
+		"other recipient - reject": {
+			encoder: EncodeBankMsgWithSelfSendCheck(EncodeBankMsg, true),
+			msg:     send,
+			expMsgs: []sdk.Msg{&banktypes.MsgSend{FromAddress: myAddr.String(), ToAddress: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := spec.encoder(myAddr, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeMultiSend(t *testing.T) {
+	var (
+		myAddr = RandomAccountAddress(t)
+		addr1  = RandomAccountAddress(t)
+		addr2  = RandomAccountAddress(t)
+	)
+	outputs := []wasmvmtypes.SendMsg{
+		{ToAddress: addr1.String(), Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "alx")}},
+		{ToAddress: addr2.String(), Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(2, "alx"), wasmvmtypes.NewCoin(3, "blx")}},
+	}
+	specs := map[string]struct {
+		amount  wasmvmtypes.Array[wasmvmtypes.Coin]
+		outputs []wasmvmtypes.SendMsg
+		expMsgs []sdk.Msg
+		expErr  error
+	}{
+		"balanced": {
+			amount:  wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(3, "alx"), wasmvmtypes.NewCoin(3, "blx")},
+			outputs: outputs,
+			expMsgs: []sdk.Msg{&banktypes.MsgMultiSend{
+				Inputs: []banktypes.Input{{Address: myAddr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("alx", 3), sdk.NewInt64Coin("blx", 3))}},
+				Outputs: []banktypes.Output{
+					{Address: addr1.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("alx", 1))},
+					{Address: addr2.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("alx", 2), sdk.NewInt64Coin("blx", 3))},
+				},
+			}},
+		},
+		"unbalanced - more input": {
+			amount:  wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(4, "alx"), wasmvmtypes.NewCoin(3, "blx")},
+			outputs: outputs,
+			expErr:  banktypes.ErrInputOutputMismatch,
+		},
+		"unbalanced - less input": {
+			amount:  wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(3, "alx")},
+			outputs: outputs,
+			expErr:  banktypes.ErrInputOutputMismatch,
+		},
+		"no outputs": {
+			amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(3, "alx")},
+			expErr: banktypes.ErrNoOutputs,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := EncodeMultiSend(myAddr, spec.amount, spec.outputs)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeVestingSend(t *testing.T) {
+	var (
+		myAddr    = RandomAccountAddress(t)
+		recipient = RandomAccountAddress(t)
+	)
+	specs := map[string]struct {
+		vestingEnabled bool
+		msg            VestingSendMsg
+		expMsgs        []sdk.Msg
+		expErr         error
+	}{
+		"continuous vesting": {
+			vestingEnabled: true,
+			msg: VestingSendMsg{
+				ToAddress: recipient.String(),
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "alx")},
+				EndTime:   1700000000,
+			},
+			expMsgs: []sdk.Msg{&vestingtypes.MsgCreateVestingAccount{
+				FromAddress: myAddr.String(),
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("alx", 100)),
+				EndTime:     1700000000,
+			}},
+		},
+		"delayed vesting": {
+			vestingEnabled: true,
+			msg: VestingSendMsg{
+				ToAddress: recipient.String(),
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "alx")},
+				EndTime:   1700000000,
+				Delayed:   true,
+			},
+			expMsgs: []sdk.Msg{&vestingtypes.MsgCreateVestingAccount{
+				FromAddress: myAddr.String(),
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("alx", 100)),
+				EndTime:     1700000000,
+				Delayed:     true,
+			}},
+		},
+		"vesting not enabled": {
+			msg: VestingSendMsg{
+				ToAddress: recipient.String(),
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "alx")},
+				EndTime:   1700000000,
+			},
+			expErr: types.ErrInvalidMsg,
+		},
+		"no end time": {
+			vestingEnabled: true,
+			msg: VestingSendMsg{
+				ToAddress: recipient.String(),
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "alx")},
+			},
+			expErr: types.ErrEmpty,
+		},
+		"end time overflow": {
+			vestingEnabled: true,
+			msg: VestingSendMsg{
+				ToAddress: recipient.String(),
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, "alx")},
+				EndTime:   math.MaxUint64,
+			},
+			expErr: types.ErrLimit,
+		},
+		"no amount": {
+			vestingEnabled: true,
+			msg: VestingSendMsg{
+				ToAddress: recipient.String(),
+				EndTime:   1700000000,
+			},
+			expErr: types.ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := EncodeVestingSend(spec.vestingEnabled, myAddr, &spec.msg)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeWithDenomResolver(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	const (
+		tracePath = "transfer/channel-0/uatom"
+		ibcDenom  = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	)
+	specs := map[string]struct {
+		denom    string
+		expDenom string
+	}{
+		"denom path resolved to hash": {
+			denom:    tracePath,
+			expDenom: ibcDenom,
+		},
+		"native denom passthrough": {
+			denom:    "uatom",
+			expDenom: "uatom",
+		},
+		"ibc denom passthrough": {
+			denom:    ibcDenom,
+			expDenom: ibcDenom,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			bankEncoder := EncodeBankMsgWithDenomResolver(EncodeBankMsg, IBCDenomTraceResolver)
+			srcBankMsg := &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+				ToAddress: myAddr.String(),
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, spec.denom)},
+			}}
+			gotMsgs, err := bankEncoder(myAddr, srcBankMsg)
+			require.NoError(t, err)
+			require.Len(t, gotMsgs, 1)
+			assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(spec.expDenom, 1)), gotMsgs[0].(*banktypes.MsgSend).Amount)
+			// source msg not modified
+			assert.Equal(t, spec.denom, srcBankMsg.Send.Amount[0].Denom)
+
+			ibcEncoder := EncodeIBCMsgWithDenomResolver(EncodeIBCMsg(wasmtesting.MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+				return "myTransferPort"
+			}}), IBCDenomTraceResolver)
+			gotMsgs, err = ibcEncoder(sdk.Context{}, myAddr, "", &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{
+				ChannelID: "channel-1",
+				ToAddress: myAddr.String(),
+				Amount:    wasmvmtypes.NewCoin(1, spec.denom),
+				Timeout:   wasmvmtypes.IBCTimeout{Timestamp: 100},
+			}})
+			require.NoError(t, err)
+			require.Len(t, gotMsgs, 1)
+			assert.Equal(t, sdk.NewInt64Coin(spec.expDenom, 1), gotMsgs[0].(*ibctransfertypes.MsgTransfer).Token)
+		})
+	}
+}
+
+func TestEncodeIBCMsgWithReceiverValidator(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	specs := map[string]struct {
+		receiver string
+		expErr   *errorsmod.Error
+	}{
+		"valid receiver": {
+			receiver: "osmo1pkptre7fdkl6gfrzlesjjvhxhlc3r4gmmk8rs6",
+		},
+		"receiver at max length": {
+			receiver: strings.Repeat("a", 64),
+		},
+		"empty receiver": {
+			receiver: "",
+			expErr:   types.ErrEmpty,
+		},
+		"blank receiver": {
+			receiver: "  ",
+			expErr:   types.ErrEmpty,
+		},
+		"receiver too long": {
+			receiver: strings.Repeat("a", 65),
+			expErr:   types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ibcEncoder := EncodeIBCMsg(wasmtesting.MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+				return "myTransferPort"
+			}})
+			encoder := EncodeIBCMsgWithReceiverValidator(ibcEncoder, MaxLengthReceiverValidator(64))
+			// when
+			gotMsgs, gotErr := encoder(sdk.Context{}, myAddr, "", &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{
+				ChannelID: "channel-1",
+				ToAddress: spec.receiver,
+				Amount:    wasmvmtypes.NewCoin(1, "denom"),
+				Timeout:   wasmvmtypes.IBCTimeout{Timestamp: 100},
+			}})
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, gotMsgs, 1)
+			assert.Equal(t, spec.receiver, gotMsgs[0].(*ibctransfertypes.MsgTransfer).Receiver)
+		})
+	}
+}
+
+func TestEncodeIBCMsgWithRestrictedDenoms(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	isRestricted := func(denom string) bool { return denom == "stdenom" }
+	specs := map[string]struct {
+		denom  string
+		expErr *errorsmod.Error
+	}{
+		"allowed denom": {
+			denom: "denom",
+		},
+		"restricted denom": {
+			denom:  "stdenom",
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ibcEncoder := EncodeIBCMsg(wasmtesting.MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+				return "myTransferPort"
+			}})
+			encoder := EncodeIBCMsgWithRestrictedDenoms(ibcEncoder, isRestricted)
+			// when
+			gotMsgs, gotErr := encoder(sdk.Context{}, myAddr, "", &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{
+				ChannelID: "channel-1",
+				ToAddress: "osmo1pkptre7fdkl6gfrzlesjjvhxhlc3r4gmmk8rs6",
+				Amount:    wasmvmtypes.NewCoin(1, spec.denom),
+				Timeout:   wasmvmtypes.IBCTimeout{Timestamp: 100},
+			}})
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, gotMsgs, 1)
+			assert.Equal(t, sdk.NewInt64Coin(spec.denom, 1), gotMsgs[0].(*ibctransfertypes.MsgTransfer).Token)
+		})
+	}
+	// and other messages pass
+	encoder := EncodeIBCMsgWithRestrictedDenoms(EncodeIBCMsg(nil), isRestricted)
+	gotMsgs, gotErr := encoder(sdk.Context{}, myAddr, "", &wasmvmtypes.IBCMsg{CloseChannel: &wasmvmtypes.CloseChannelMsg{ChannelID: "channel-1"}})
+	require.NoError(t, gotErr)
+	assert.Len(t, gotMsgs, 1)
+}
+
+func TestEncodeTransferWithRelativeTimeout(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.Context{}.WithBlockTime(blockTime)
+	portSource := wasmtesting.MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}}
+	specs := map[string]struct {
+		timeoutSeconds uint64
+		expTimeout     uint64
+		expErr         error
+	}{
+		"relative timeout": {
+			timeoutSeconds: 60,
+			expTimeout:     uint64(blockTime.Add(time.Minute).UnixNano()),
+		},
+		"zero timeout": {
+			timeoutSeconds: 0,
+			expErr:         types.ErrEmpty,
+		},
+		"timeout overflow": {
+			timeoutSeconds: math.MaxUint64,
+			expErr:         types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotMsgs, gotErr := EncodeTransferWithRelativeTimeout(ctx, portSource, myAddr, &TransferWithRelativeTimeoutMsg{
+				ChannelID:      "channel-1",
+				ToAddress:      "osmo1pkptre7fdkl6gfrzlesjjvhxhlc3r4gmmk8rs6",
+				Amount:         wasmvmtypes.NewCoin(1, "denom"),
+				TimeoutSeconds: spec.timeoutSeconds,
+				Memo:           "my memo",
+			})
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := []sdk.Msg{&ibctransfertypes.MsgTransfer{
+				SourcePort:       "myTransferPort",
+				SourceChannel:    "channel-1",
+				Token:            sdk.NewInt64Coin("denom", 1),
+				Sender:           myAddr.String(),
+				Receiver:         "osmo1pkptre7fdkl6gfrzlesjjvhxhlc3r4gmmk8rs6",
+				TimeoutTimestamp: spec.expTimeout,
+				Memo:             "my memo",
+			}}
+			assert.Equal(t, exp, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeCloseChannelConfirm(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	specs := map[string]struct {
+		msg     CloseChannelConfirmMsg
+		expMsgs []sdk.Msg
+		expErr  error
+	}{
+		"valid close confirm": {
+			msg: CloseChannelConfirmMsg{
+				ChannelID:   "channel-1",
+				ProofInit:   []byte("proof"),
+				ProofHeight: wasmvmtypes.IBCTimeoutBlock{Revision: 1, Height: 2},
+			},
+			expMsgs: []sdk.Msg{&channeltypes.MsgChannelCloseConfirm{
+				PortId:      PortIDForContract(myAddr),
+				ChannelId:   "channel-1",
+				ProofInit:   []byte("proof"),
+				ProofHeight: clienttypes.NewHeight(1, 2),
+				Signer:      myAddr.String(),
+			}},
+		},
+		"missing proof": {
+			msg: CloseChannelConfirmMsg{
+				ChannelID:   "channel-1",
+				ProofHeight: wasmvmtypes.IBCTimeoutBlock{Revision: 1, Height: 2},
+			},
+			expErr: types.ErrEmpty,
+		},
+		"missing proof height": {
+			msg: CloseChannelConfirmMsg{
+				ChannelID: "channel-1",
+				ProofInit: []byte("proof"),
+			},
+			expErr: types.ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := EncodeCloseChannelConfirm(myAddr, &spec.msg)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeAbstainVote(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	exp := []sdk.Msg{
+		&govv1.MsgVoteWeighted{
+			ProposalId: 1,
+			Voter:      myAddr.String(),
+			Options: []*govv1.WeightedVoteOption{
+				{Option: govv1.OptionAbstain, Weight: sdkmath.LegacyOneDec().String()},
+			},
+		},
+	}
+	got, err := EncodeAbstainVote(myAddr, 1)
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
+func TestEncodeDistributionMsgWithBlockedWithdrawAddresses(t *testing.T) {
+	var (
+		myAddr      = RandomAccountAddress(t)
+		blockedAddr = authtypes.NewModuleAddress(distributiontypes.ModuleName).String()
+		otherAddr   = RandomBech32AccountAddress(t)
+	)
+	encoder := EncodeDistributionMsgWithBlockedWithdrawAddresses(EncodeDistributionMsg, func(addr string) bool {
+		return addr == blockedAddr
+	})
+	setWithdrawAddress := func(addr string) *wasmvmtypes.DistributionMsg {
+		return &wasmvmtypes.DistributionMsg{SetWithdrawAddress: &wasmvmtypes.SetWithdrawAddressMsg{Address: addr}}
+	}
+	specs := map[string]struct {
+		msg    *wasmvmtypes.DistributionMsg
+		expErr error
+	}{
+		"valid address": {
+			msg: setWithdrawAddress(otherAddr),
+		},
+		"malformed address": {
+			msg:    setWithdrawAddress("not a valid address"),
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"blocked module account": {
+			msg:    setWithdrawAddress(blockedAddr),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"other message": {
+			msg: &wasmvmtypes.DistributionMsg{WithdrawDelegatorReward: &wasmvmtypes.WithdrawDelegatorRewardMsg{Validator: blockedAddr}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := encoder(myAddr, spec.msg)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expMsgs, err := EncodeDistributionMsg(myAddr, spec.msg)
+			require.NoError(t, err)
+			assert.Equal(t, expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeDepositValidatorRewardsPool(t *testing.T) {
+	var (
+		myAddr  = RandomAccountAddress(t)
+		valAddr = make(sdk.ValAddress, types.SDKAddrLen)
+	)
+	valAddr[0] = 12
+	specs := map[string]struct {
+		amount  wasmvmtypes.Array[wasmvmtypes.Coin]
+		expMsgs []sdk.Msg
+		expErr  bool
+	}{
+		"valid deposit": {
+			amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1234, "stake")},
+			expMsgs: []sdk.Msg{&distributiontypes.MsgDepositValidatorRewardsPool{
+				Depositor:        myAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           sdk.NewCoins(sdk.NewInt64Coin("stake", 1234)),
+			}},
+		},
+		"invalid denom": {
+			amount: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1234, "!")},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := EncodeDepositValidatorRewardsPool(myAddr, valAddr.String(), spec.amount)
+			if spec.expErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeAnyMsgWithTypeURLRules(t *testing.T) {
+	var (
+		myAddr = RandomAccountAddress(t)
+		addr1  = RandomAccountAddress(t)
+	)
+	voteMsg := govv1.NewMsgVote(myAddr, 1, govv1.OptionYes, "")
+	depositMsg := govv1.NewMsgDeposit(myAddr, 1, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	bankMsg := &banktypes.MsgSend{FromAddress: myAddr.String(), ToAddress: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}
+	toAnyMsg := func(m sdk.Msg) *wasmvmtypes.AnyMsg {
+		return &wasmvmtypes.AnyMsg{TypeURL: sdk.MsgTypeURL(m), Value: must(proto.Marshal(m))}
+	}
+	rules := []AnyTypeURLRule{
+		{Pattern: "cosmos.gov.v1.MsgDeposit", Allow: true},
+		{Pattern: "cosmos.gov.*"},
+		{Pattern: "cosmwasm.wasm.*"},
+	}
+	specs := map[string]struct {
+		msg     sdk.Msg
+		expMsgs []sdk.Msg
+		expErr  error
+	}{
+		"gov type url blocked": {
+			msg:    voteMsg,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"gov type url explicitly allowed": {
+			msg:     depositMsg,
+			expMsgs: []sdk.Msg{depositMsg},
+		},
+		"wasm type url blocked": {
+			msg:    &types.MsgExecuteContract{Sender: myAddr.String(), Contract: addr1.String(), Msg: []byte(`{}`)},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"no matching rule": {
+			msg:     bankMsg,
+			expMsgs: []sdk.Msg{bankMsg},
+		},
+	}
+	encodingConfig := MakeEncodingConfig(t)
+	encoder := EncodeAnyMsgWithTypeURLRules(EncodeAnyMsg(encodingConfig.Codec), rules...)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			gotMsgs, gotErr := encoder(ctx, myAddr, toAnyMsg(spec.msg))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeAnyMsgWithSignerCheck(t *testing.T) {
+	var (
+		myAddr = RandomAccountAddress(t)
+		addr1  = RandomAccountAddress(t)
+	)
+	toAnyMsg := func(m sdk.Msg) *wasmvmtypes.AnyMsg {
+		return &wasmvmtypes.AnyMsg{TypeURL: sdk.MsgTypeURL(m), Value: must(proto.Marshal(m))}
+	}
+	specs := map[string]struct {
+		msg    sdk.Msg
+		expErr error
+	}{
+		"signer matches contract": {
+			msg: &banktypes.MsgSend{FromAddress: myAddr.String(), ToAddress: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+		},
+		"signer differs": {
+			msg:    &banktypes.MsgSend{FromAddress: addr1.String(), ToAddress: myAddr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"gov vote signer differs": {
+			msg:    govv1.NewMsgVote(addr1, 1, govv1.OptionYes, ""),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	encodingConfig := MakeEncodingConfig(t)
+	encoder := EncodeAnyMsgWithSignerCheck(EncodeAnyMsg(encodingConfig.Codec), encodingConfig.Codec)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			gotMsgs, gotErr := encoder(ctx, myAddr, toAnyMsg(spec.msg))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, []sdk.Msg{spec.msg}, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeCustomMsgWithNoop(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	encoder := EncodeCustomMsgWithNoop(NoCustomMsg)
+	specs := map[string]struct {
+		msg    string
+		expErr error
+	}{
+		"noop": {
+			msg: `{"noop":{}}`,
+		},
+		"noop with whitespace": {
+			msg: `{ "noop" : { } }`,
+		},
+		"noop with content": {
+			msg:    `{"noop":{"foo":"bar"}}`,
+			expErr: types.ErrUnknownMsg,
+		},
+		"noop null": {
+			msg:    `{"noop":null}`,
+			expErr: types.ErrUnknownMsg,
+		},
+		"noop with other key": {
+			msg:    `{"noop":{},"foo":{}}`,
+			expErr: types.ErrUnknownMsg,
+		},
+		"other custom msg": {
+			msg:    `{"foo":{}}`,
+			expErr: types.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := encoder(myAddr, json.RawMessage(spec.msg))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeAnyMsgWithRouterCheck(t *testing.T) {
+	var (
+		myAddr = RandomAccountAddress(t)
+		addr1  = RandomAccountAddress(t)
+	)
+	toAnyMsg := func(m sdk.Msg) *wasmvmtypes.AnyMsg {
+		return &wasmvmtypes.AnyMsg{TypeURL: sdk.MsgTypeURL(m), Value: must(proto.Marshal(m))}
+	}
+	specs := map[string]struct {
+		msg    sdk.Msg
+		expErr error
+	}{
+		"routable": {
+			msg: &banktypes.MsgSend{FromAddress: myAddr.String(), ToAddress: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+		},
+		"no handler registered": {
+			msg:    govv1.NewMsgVote(myAddr, 1, govv1.OptionYes, ""),
+			expErr: sdkerrors.ErrUnknownRequest,
+		},
+	}
+	encodingConfig := MakeEncodingConfig(t)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+	banktypes.RegisterMsgServer(router, bankkeeper.NewMsgServerImpl(bankkeeper.BaseKeeper{}))
+	encoder := EncodeAnyMsgWithRouterCheck(EncodeAnyMsg(encodingConfig.Codec), router)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			gotMsgs, gotErr := encoder(ctx, myAddr, toAnyMsg(spec.msg))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, []sdk.Msg{spec.msg}, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeWasmMsgWithBlockedAdmins(t *testing.T) {
+	var (
+		myAddr       = RandomAccountAddress(t)
+		blockedAdmin = RandomBech32AccountAddress(t)
+		otherAdmin   = RandomBech32AccountAddress(t)
+	)
+	encoder := EncodeWasmMsgWithBlockedAdmins(EncodeWasmMsg, func(admin string) bool {
+		return admin == blockedAdmin
+	})
+	instantiate := func(admin string) *wasmvmtypes.WasmMsg {
+		return &wasmvmtypes.WasmMsg{Instantiate: &wasmvmtypes.InstantiateMsg{CodeID: 1, Msg: []byte(`{}`), Label: "foo", Admin: admin}}
+	}
+	instantiate2 := func(admin string) *wasmvmtypes.WasmMsg {
+		return &wasmvmtypes.WasmMsg{Instantiate2: &wasmvmtypes.Instantiate2Msg{CodeID: 1, Msg: []byte(`{}`), Label: "foo", Admin: admin, Salt: []byte("salt")}}
+	}
+	specs := map[string]struct {
+		msg    *wasmvmtypes.WasmMsg
+		expErr error
+	}{
+		"instantiate - allowed admin": {
+			msg: instantiate(otherAdmin),
+		},
+		"instantiate - no admin": {
+			msg: instantiate(""),
+		},
+		"instantiate - blocked admin": {
+			msg:    instantiate(blockedAdmin),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"instantiate2 - allowed admin": {
+			msg: instantiate2(otherAdmin),
+		},
+		"instantiate2 - blocked admin": {
+			msg:    instantiate2(blockedAdmin),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"other message": {
+			msg: &wasmvmtypes.WasmMsg{UpdateAdmin: &wasmvmtypes.UpdateAdminMsg{ContractAddr: RandomBech32AccountAddress(t), Admin: blockedAdmin}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := encoder(myAddr, spec.msg)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expMsgs, err := EncodeWasmMsg(myAddr, spec.msg)
+			require.NoError(t, err)
+			assert.Equal(t, expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeIBCv2MsgWithEncodingAllowList(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	encoder := EncodeIBCv2MsgWithEncodingAllowList(EncodeIBCv2Msg, DefaultIBC2PayloadEncodings...)
+	sendPacket := func(encodings ...string) *wasmvmtypes.IBC2Msg {
+		payloads := make([]wasmvmtypes.IBC2Payload, len(encodings))
+		for i, e := range encodings {
+			payloads[i] = wasmvmtypes.IBC2Payload{
+				SourcePort:      PortIDForContractV2(myAddr),
+				DestinationPort: "destPort",
+				Version:         "v1",
+				Encoding:        e,
+				Value:           []byte("{}"),
+			}
+		}
+		return &wasmvmtypes.IBC2Msg{SendPacket: &wasmvmtypes.IBC2SendPacketMsg{SourceClient: "client-0", Payloads: payloads, Timeout: 1000000000000}}
+	}
+	specs := map[string]struct {
+		msg    *wasmvmtypes.IBC2Msg
+		expErr error
+	}{
+		"known encoding": {
+			msg: sendPacket("application/json"),
+		},
+		"all known encodings": {
+			msg: sendPacket("application/json", "application/x-protobuf", "application/x-solidity-abi"),
+		},
+		"unknown encoding": {
+			msg:    sendPacket("application/json", "json"),
+			expErr: types.ErrInvalidMsg,
+		},
+		"empty encoding": {
+			msg:    sendPacket(""),
+			expErr: types.ErrEmpty,
+		},
+		"other message": {
+			msg:    &wasmvmtypes.IBC2Msg{WriteAcknowledgement: &wasmvmtypes.IBC2WriteAcknowledgementMsg{Ack: wasmvmtypes.IBCAcknowledgement{Data: []byte("ack")}}},
+			expErr: types.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := encoder(myAddr, spec.msg)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expMsgs, err := EncodeIBCv2Msg(myAddr, spec.msg)
+			require.NoError(t, err)
+			assert.Equal(t, expMsgs, gotMsgs)
+		})
+	}
+	// and a custom allow list
+	_, err := EncodeIBCv2MsgWithEncodingAllowList(EncodeIBCv2Msg, "json")(myAddr, sendPacket("json"))
+	require.NoError(t, err)
+}
+
+func TestEncodeIBCv2Msg(t *testing.T) {
+	var (
+		myAddr   = RandomAccountAddress(t)
+		destAddr = RandomAccountAddress(t)
+	)
+
+	cases := map[string]struct {
+		sender             sdk.AccAddress
+		srcMsg             wasmvmtypes.CosmosMsg
+		transferPortSource types.ICS20TransferPortSource
+		// set if valid
+		output []sdk.Msg
+		// set if expect mapping fails
+		expError bool
+	}{
+		"IBC2 SendPacket": {
+			sender: myAddr,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				IBC2: &wasmvmtypes.IBC2Msg{
+					SendPacket: &wasmvmtypes.IBC2SendPacketMsg{
+						SourceClient: myAddr.String(),
+						Payloads: []wasmvmtypes.IBC2Payload{
+							{
+								SourcePort:      PortIDForContractV2(myAddr),
+								DestinationPort: PortIDForContractV2(destAddr),
+								Version:         "v1",
+								Encoding:        "json",
+								Value:           []byte{},
+							},
+						},
+						Timeout: 1000000000000,
+					},
+				},
+			},
+			output: []sdk.Msg{
+				&channeltypesv2.MsgSendPacket{
+					SourceClient:     myAddr.String(),
+					TimeoutTimestamp: 1000,
+					Signer:           myAddr.String(),
+					Payloads: []channeltypesv2.Payload{
+						{
+							SourcePort:      PortIDForContractV2(myAddr),
+							DestinationPort: PortIDForContractV2(destAddr),
+							Version:         "v1",
+							Encoding:        "json",
+							Value:           []byte{},
+						},
+					},
+				},
+			},
+		},
+		"IBC2 WriteAcknowledgement": {
+			sender: myAddr,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				IBC2: &wasmvmtypes.IBC2Msg{
+					WriteAcknowledgement: &wasmvmtypes.IBC2WriteAcknowledgementMsg{
+						Ack:            wasmvmtypes.IBCAcknowledgement{Data: []byte("ack")},
+						SourceClient:   myAddr.String(),
+						PacketSequence: 1,
+					},
+				},
+			},
+			expError: true,
+		},
+		"IBC2 WriteAcknowledgement with result and error": {
+			sender: myAddr,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				IBC2: &wasmvmtypes.IBC2Msg{
+					WriteAcknowledgement: &wasmvmtypes.IBC2WriteAcknowledgementMsg{
+						Ack:            wasmvmtypes.IBCAcknowledgement{Data: []byte(`{"result":"b2s=","error":"my error"}`)},
+						SourceClient:   myAddr.String(),
+						PacketSequence: 1,
+					},
+				},
+			},
+			expError: true,
+		},
+	}
+	encodingConfig := MakeEncodingConfig(t)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			encoder := DefaultEncoders(encodingConfig.Codec, tc.transferPortSource)
+			res, gotEncErr := encoder.Encode(ctx, tc.sender, "myIBCPort", tc.srcMsg)
+			if tc.expError {
+				assert.Error(t, gotEncErr)
+				return
+			}
+			require.NoError(t, gotEncErr)
+			assert.Equal(t, tc.output, res)
+		})
+	}
+}
+
+func TestConvertIBC2Acknowledgement(t *testing.T) {
+	specs := map[string]struct {
+		src        wasmvmtypes.IBCAcknowledgement
+		expAck     channeltypesv2.Acknowledgement
+		expSuccess bool
+		expErr     error
+	}{
+		"success ack": {
+			src:        wasmvmtypes.IBCAcknowledgement{Data: []byte(`{"result":"b2s="}`)},
+			expAck:     channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{[]byte(`{"result":"b2s="}`)}},
+			expSuccess: true,
+		},
+		"raw success ack": {
+			src:        wasmvmtypes.IBCAcknowledgement{Data: []byte("ok")},
+			expAck:     channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{[]byte("ok")}},
+			expSuccess: true,
+		},
+		"error ack": {
+			src:    wasmvmtypes.IBCAcknowledgement{Data: []byte(`{"error":"my error"}`)},
+			expAck: channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{channeltypesv2.ErrorAcknowledgement[:]}},
+		},
+		"universal error ack": {
+			src:    wasmvmtypes.IBCAcknowledgement{Data: channeltypesv2.ErrorAcknowledgement[:]},
+			expAck: channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{channeltypesv2.ErrorAcknowledgement[:]}},
+		},
+		"both set": {
+			src:    wasmvmtypes.IBCAcknowledgement{Data: []byte(`{"result":"b2s=","error":"my error"}`)},
+			expErr: channeltypesv2.ErrInvalidAcknowledgement,
+		},
+		"empty": {
+			src:    wasmvmtypes.IBCAcknowledgement{},
+			expErr: channeltypesv2.ErrInvalidAcknowledgement,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotAck, gotSuccess, gotErr := ConvertIBC2Acknowledgement(spec.src)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expAck, gotAck)
+			assert.Equal(t, spec.expSuccess, gotSuccess)
+		})
+	}
+}
+
+func TestEncodeLegacyStargateMsg(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: myAddr.String(),
+		ToAddress:   RandomBech32AccountAddress(t),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 12345)),
+	}
+	bankMsgBin := must(proto.Marshal(bankMsg))
+	encodingConfig := MakeEncodingConfig(t)
+	encoder := DefaultEncoders(encodingConfig.Codec, wasmtesting.MockIBCTransferKeeper{})
+
+	encode := func(t *testing.T, variant string) ([]sdk.Msg, storetypes.Gas) {
+		t.Helper()
+		src := fmt.Sprintf(`{%q:{"type_url":"/cosmos.bank.v1beta1.MsgSend","value":%q}}`, variant, base64.StdEncoding.EncodeToString(bankMsgBin))
+		var msg wasmvmtypes.CosmosMsg
+		require.NoError(t, json.Unmarshal([]byte(src), &msg))
+		ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		res, err := encoder.Encode(ctx, myAddr, "", msg)
+		require.NoError(t, err)
+		return res, ctx.GasMeter().GasConsumed()
+	}
+	expMsgs, expGas := encode(t, "any")
+	gotMsgs, gotGas := encode(t, "stargate")
+	assert.Equal(t, []sdk.Msg{bankMsg}, gotMsgs)
+	assert.Equal(t, expMsgs, gotMsgs)
+	assert.Equal(t, expGas, gotGas)
+}
+
+func TestEncodeStakingMsgWithRedelegationLimit(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	valAddr := make(sdk.ValAddress, types.SDKAddrLen)
+	valAddr[0] = 12
+	valAddr2 := make(sdk.ValAddress, types.SDKAddrLen)
+	valAddr2[1] = 123
+	redelegateMsg := &wasmvmtypes.StakingMsg{
+		Redelegate: &wasmvmtypes.RedelegateMsg{
+			SrcValidator: valAddr.String(),
+			DstValidator: valAddr2.String(),
+			Amount:       wasmvmtypes.NewCoin(777, "stake"),
+		},
+	}
+	specs := map[string]struct {
+		entries uint32
+		msg     *wasmvmtypes.StakingMsg
+		expErr  *errorsmod.Error
+	}{
+		"below max entries": {
+			entries: 6,
+			msg:     redelegateMsg,
+		},
+		"at max entries": {
+			entries: 7,
+			msg:     redelegateMsg,
+			expErr:  types.ErrLimit,
+		},
+		"non redelegate msg not checked": {
+			entries: 7,
+			msg: &wasmvmtypes.StakingMsg{
+				Delegate: &wasmvmtypes.DelegateMsg{
+					Validator: valAddr.String(),
+					Amount:    wasmvmtypes.NewCoin(777, "stake"),
+				},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			counter := func(_ sdk.Context, delegator sdk.AccAddress, src, dst string) (uint32, error) {
+				assert.Equal(t, myAddr, delegator)
+				assert.Equal(t, valAddr.String(), src)
+				assert.Equal(t, valAddr2.String(), dst)
+				return spec.entries, nil
+			}
+			encoder := EncodeStakingMsgWithRedelegationLimit(EncodeStakingMsg, counter, 7)
+			// when
+			gotMsgs, gotErr := encoder(ctx, myAddr, spec.msg)
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expMsgs, err := EncodeStakingMsg(myAddr, spec.msg)
+			require.NoError(t, err)
+			assert.Equal(t, expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeDelegateWithAutoCompound(t *testing.T) {
+	var (
+		myAddr       = RandomAccountAddress(t)
+		valAddr      = sdk.ValAddress(RandomAccountAddress(t)).String()
+		delegateMsg  = &stakingtypes.MsgDelegate{DelegatorAddress: myAddr.String(), ValidatorAddress: valAddr, Amount: sdk.NewInt64Coin("stake", 100)}
+		compoundMsg  = &distributiontypes.MsgSetWithdrawAddress{DelegatorAddress: myAddr.String(), WithdrawAddress: myAddr.String()}
+		compoundFact = func(delegator sdk.AccAddress, validator string) (sdk.Msg, error) {
+			require.Equal(t, myAddr, delegator)
+			require.Equal(t, valAddr, validator)
+			return compoundMsg, nil
+		}
+	)
+	specs := map[string]struct {
+		factory AutoCompoundMsgFactory
+		msg     *DelegateWithAutoCompoundMsg
+		expMsgs []sdk.Msg
+		expErr  error
+	}{
+		"delegate only": {
+			factory: compoundFact,
+			msg:     &DelegateWithAutoCompoundMsg{Validator: valAddr, Amount: wasmvmtypes.NewCoin(100, "stake")},
+			expMsgs: []sdk.Msg{delegateMsg},
+		},
+		"delegate with auto compound": {
+			factory: compoundFact,
+			msg:     &DelegateWithAutoCompoundMsg{Validator: valAddr, Amount: wasmvmtypes.NewCoin(100, "stake"), AutoCompound: true},
+			expMsgs: []sdk.Msg{delegateMsg, compoundMsg},
+		},
+		"delegate only - no factory": {
+			msg:     &DelegateWithAutoCompoundMsg{Validator: valAddr, Amount: wasmvmtypes.NewCoin(100, "stake")},
+			expMsgs: []sdk.Msg{delegateMsg},
+		},
+		"auto compound - no factory": {
+			msg:    &DelegateWithAutoCompoundMsg{Validator: valAddr, Amount: wasmvmtypes.NewCoin(100, "stake"), AutoCompound: true},
+			expErr: types.ErrInvalidMsg,
+		},
+		"auto compound - factory fails": {
+			factory: func(sdk.AccAddress, string) (sdk.Msg, error) { return nil, types.ErrInvalid },
+			msg:     &DelegateWithAutoCompoundMsg{Validator: valAddr, Amount: wasmvmtypes.NewCoin(100, "stake"), AutoCompound: true},
+			expErr:  types.ErrInvalid,
+		},
+		"invalid amount": {
+			factory: compoundFact,
+			msg:     &DelegateWithAutoCompoundMsg{Validator: valAddr, Amount: wasmvmtypes.Coin{Denom: "stake", Amount: "foo"}, AutoCompound: true},
+			expErr:  sdkerrors.ErrInvalidCoins,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := EncodeDelegateWithAutoCompound(spec.factory, myAddr, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestEncodeStakingWithContextPrecedence(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	valAddr := make(sdk.ValAddress, types.SDKAddrLen)
+	valAddr[0] = 12
+	valAddr2 := make(sdk.ValAddress, types.SDKAddrLen)
+	valAddr2[1] = 123
+	msg := wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{
+		Redelegate: &wasmvmtypes.RedelegateMsg{
+			SrcValidator: valAddr.String(),
+			DstValidator: valAddr2.String(),
+			Amount:       wasmvmtypes.NewCoin(777, "stake"),
+		},
+	}}
+	counter := func(_ sdk.Context, _ sdk.AccAddress, _, _ string) (uint32, error) {
+		return 7, nil
+	}
+	var ctx sdk.Context
+	encoders := DefaultEncoders(MakeEncodingConfig(t).Codec, nil)
+	// when
+	_, gotErr := encoders.Encode(ctx, myAddr, "", msg)
+	// then
+	require.NoError(t, gotErr)
+
+	// when
+	encoders = encoders.Merge(&MessageEncoders{StakingWithContext: EncodeStakingMsgWithRedelegationLimit(EncodeStakingMsg, counter, 7)})
+	_, gotErr = encoders.Encode(ctx, myAddr, "", msg)
+	// then
+	assert.ErrorIs(t, gotErr, types.ErrLimit)
+}
+
+func TestEncodeStakingMsgRejectZeroAmount(t *testing.T) {
+	myAddr := RandomAccountAddress(t)
+	valAddr := make(sdk.ValAddress, types.SDKAddrLen)
+	valAddr[0] = 12
+	valAddr2 := make(sdk.ValAddress, types.SDKAddrLen)
+	valAddr2[1] = 123
+	zero := wasmvmtypes.NewCoin(0, "stake")
+	specs := map[string]struct {
+		msg    *wasmvmtypes.StakingMsg
+		expErr *errorsmod.Error
+	}{
+		"delegate zero": {
+			msg:    &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{Validator: valAddr.String(), Amount: zero}},
+			expErr: types.ErrEmpty,
+		},
+		"undelegate zero": {
+			msg:    &wasmvmtypes.StakingMsg{Undelegate: &wasmvmtypes.UndelegateMsg{Validator: valAddr.String(), Amount: zero}},
+			expErr: types.ErrEmpty,
+		},
+		"redelegate zero": {
+			msg:    &wasmvmtypes.StakingMsg{Redelegate: &wasmvmtypes.RedelegateMsg{SrcValidator: valAddr.String(), DstValidator: valAddr2.String(), Amount: zero}},
+			expErr: types.ErrEmpty,
+		},
+		"delegate with amount": {
+			msg: &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{Validator: valAddr.String(), Amount: wasmvmtypes.NewCoin(777, "stake")}},
+		},
+		"undelegate with amount": {
+			msg: &wasmvmtypes.StakingMsg{Undelegate: &wasmvmtypes.UndelegateMsg{Validator: valAddr.String(), Amount: wasmvmtypes.NewCoin(777, "stake")}},
+		},
+		"redelegate with amount": {
+			msg: &wasmvmtypes.StakingMsg{Redelegate: &wasmvmtypes.RedelegateMsg{SrcValidator: valAddr.String(), DstValidator: valAddr2.String(), Amount: wasmvmtypes.NewCoin(777, "stake")}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			encoder := EncodeStakingMsgRejectZeroAmount(EncodeStakingMsg)
+			// when
+			gotMsgs, gotErr := encoder(myAddr, spec.msg)
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expMsgs, err := EncodeStakingMsg(myAddr, spec.msg)
+			require.NoError(t, err)
+			assert.Equal(t, expMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestConvertWasmCoinToSdkCoin(t *testing.T) {
+	specs := map[string]struct {
+		src    wasmvmtypes.Coin
+		expErr bool
+		expVal sdk.Coin
+	}{
+		"all good": {
+			src: wasmvmtypes.Coin{
+				Denom:  "foo",
+				Amount: "1",
+			},
+			expVal: sdk.NewCoin("foo", sdkmath.NewIntFromUint64(1)),
+		},
+		"negative amount": {
+			src: wasmvmtypes.Coin{
+				Denom:  "foo",
+				Amount: "-1",
+			},
+			expErr: true,
+		},
+		"denom too short": {
+			src: wasmvmtypes.Coin{
+				Denom:  "f",
+				Amount: "1",
+			},
+			expErr: true,
+		},
+		"invalid denom char": {
+			src: wasmvmtypes.Coin{
+				Denom:  "&fff",
+				Amount: "1",
+			},
+			expErr: true,
+		},
+		"not a number amount": {
+			src: wasmvmtypes.Coin{
+				Denom:  "foo",
+				Amount: "bar",
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotVal, gotErr := ConvertWasmCoinToSdkCoin(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expVal, gotVal)
+		})
+	}
+}
+
+func TestEncodeWithDenomValidator(t *testing.T) {
+	var (
+		myAddr  = RandomAccountAddress(t)
+		addr1   = RandomBech32AccountAddress(t)
+		valAddr = sdk.ValAddress(RandomAccountAddress(t)).String()
+		coin    = func(denom string) wasmvmtypes.Coin { return wasmvmtypes.NewCoin(1, denom) }
+		coins   = func(denom string) wasmvmtypes.Array[wasmvmtypes.Coin] {
+			return wasmvmtypes.Array[wasmvmtypes.Coin]{coin(denom)}
+		}
+		bankSend = func(denom string) wasmvmtypes.CosmosMsg {
+			return wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: addr1, Amount: coins(denom)}}}
+		}
+	)
+	encoder := DefaultEncoders(nil, wasmtesting.MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}}).Merge(&MessageEncoders{DenomValidator: func(denom string) error {
+		if !strings.HasPrefix(denom, "u") {
+			return errors.New("must start with u")
+		}
+		return nil
+	}})
+
+	specs := map[string]struct {
+		src    wasmvmtypes.CosmosMsg
+		expErr *errorsmod.Error
+	}{
+		"accepted by custom rule": {
+			src: bankSend("uatom"),
+		},
+		"bank send rejected": {
+			src:    bankSend("atom"),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"bank burn rejected": {
+			src:    wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{Amount: coins("atom")}}},
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"staking delegate rejected": {
+			src:    wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{Validator: valAddr, Amount: coin("atom")}}},
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"fund community pool rejected": {
+			src:    wasmvmtypes.CosmosMsg{Distribution: &wasmvmtypes.DistributionMsg{FundCommunityPool: &wasmvmtypes.FundCommunityPoolMsg{Amount: coins("atom")}}},
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"wasm execute funds rejected": {
+			src:    wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: addr1, Msg: []byte(`{}`), Funds: coins("atom")}}},
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"ibc transfer rejected": {
+			src: wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{
+				ChannelID: "channel-1",
+				ToAddress: addr1,
+				Amount:    coin("atom"),
+				Timeout:   wasmvmtypes.IBCTimeout{Timestamp: 100},
+			}}},
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsgs, gotErr := encoder.Encode(sdk.Context{}, myAddr, "", spec.src)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, gotMsgs, 1)
+		})
+	}
+	// and the sdk rule still applies
+	_, gotErr := encoder.Encode(sdk.Context{}, myAddr, "", bankSend("u"))
+	require.Error(t, gotErr)
+	// and without validator, the denom is accepted
+	_, gotErr = DefaultEncoders(nil, nil).Encode(sdk.Context{}, myAddr, "", bankSend("atom"))
+	require.NoError(t, gotErr)
+}
+
+func TestEncodeWithDisplayUnits(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	var (
+		myAddr       = RandomAccountAddress(t)
+		addr1        = RandomBech32AccountAddress(t)
+		atomMetadata = banktypes.Metadata{
+			Base:    "uatom",
+			Display: "atom",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "uatom", Exponent: 0},
+				{Denom: "atom", Exponent: 6},
+			},
+		}
+		ethMetadata = banktypes.Metadata{
+			Base:    "wei",
+			Display: "eth",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "wei", Exponent: 0},
+				{Denom: "eth", Exponent: 18},
+			},
+		}
+		metadataGetter = func(ctx sdk.Context, displayDenom string) (banktypes.Metadata, bool) {
+			var (
+				metadata banktypes.Metadata
+				found    bool
+			)
+			keepers.BankKeeper.IterateAllDenomMetaData(ctx, func(m banktypes.Metadata) bool {
+				if m.Display == displayDenom {
+					metadata, found = m, true
+				}
+				return found
+			})
+			return metadata, found
+		}
+		bankSend = func(coin wasmvmtypes.Coin) wasmvmtypes.CosmosMsg {
+			return wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: addr1, Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{coin}}}}
+		}
+	)
+	keepers.BankKeeper.SetDenomMetaData(ctx, atomMetadata)
+	keepers.BankKeeper.SetDenomMetaData(ctx, ethMetadata)
+
+	specs := map[string]struct {
+		encoders *MessageEncoders
+		src      wasmvmtypes.Coin
+		expErr   *errorsmod.Error
+		expVal   sdk.Coin
+	}{
+		"display amount converted": {
+			encoders: &MessageEncoders{ConvertDisplayUnits: true, DenomMetadataGetter: metadataGetter},
+			src:      wasmvmtypes.Coin{Denom: "atom", Amount: "1.5"},
+			expVal:   sdk.NewCoin("uatom", sdkmath.NewInt(1_500_000)),
+		},
+		"denom without metadata passed through": {
+			encoders: &MessageEncoders{ConvertDisplayUnits: true, DenomMetadataGetter: metadataGetter},
+			src:      wasmvmtypes.Coin{Denom: "stake", Amount: "2"},
+			expVal:   sdk.NewCoin("stake", sdkmath.NewInt(2)),
+		},
+		"amount exceeds base unit precision": {
+			encoders: &MessageEncoders{ConvertDisplayUnits: true, DenomMetadataGetter: metadataGetter},
+			src:      wasmvmtypes.Coin{Denom: "atom", Amount: "0.0000001"},
+			expErr:   sdkerrors.ErrInvalidCoins,
+		},
+		"converted amount overflows": {
+			encoders: &MessageEncoders{ConvertDisplayUnits: true, DenomMetadataGetter: metadataGetter},
+			src:      wasmvmtypes.Coin{Denom: "eth", Amount: "1" + strings.Repeat("0", 60)},
+			expErr:   sdkerrors.ErrInvalidCoins,
+		},
+		"disabled - base units by default": {
+			encoders: &MessageEncoders{DenomMetadataGetter: metadataGetter},
+			src:      wasmvmtypes.Coin{Denom: "atom", Amount: "2"},
+			expVal:   sdk.NewCoin("atom", sdkmath.NewInt(2)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			encoder := DefaultEncoders(nil, nil).Merge(spec.encoders)
+			gotMsgs, gotErr := encoder.Encode(ctx, myAddr, "", bankSend(spec.src))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, gotMsgs, 1)
+			assert.Equal(t, sdk.NewCoins(spec.expVal), gotMsgs[0].(*banktypes.MsgSend).Amount)
+		})
+	}
+	// and conversion without a metadata getter is rejected
+	assert.Panics(t, func() {
+		DefaultEncoders(nil, nil).Merge(&MessageEncoders{ConvertDisplayUnits: true})
+	})
+}
+
+func TestConvertWasmCoinsToSdkCoins(t *testing.T) {
+	specs := map[string]struct {
+		src    []wasmvmtypes.Coin
+		exp    sdk.Coins
+		expErr bool
+	}{
+		"empty": {
+			src: []wasmvmtypes.Coin{},
+			exp: nil,
+		},
+		"single coin": {
+			src: []wasmvmtypes.Coin{{Denom: "foo", Amount: "1"}},
+			exp: sdk.NewCoins(sdk.NewCoin("foo", sdkmath.NewInt(1))),
+		},
+		"multiple coins": {
+			src: []wasmvmtypes.Coin{
+				{Denom: "foo", Amount: "1"},
+				{Denom: "bar", Amount: "2"},
+			},
+			exp: sdk.NewCoins(
+				sdk.NewCoin("bar", sdkmath.NewInt(2)),
+				sdk.NewCoin("foo", sdkmath.NewInt(1)),
+			),
+		},
+		"sorted": {
+			src: []wasmvmtypes.Coin{
+				{Denom: "foo", Amount: "1"},
+				{Denom: "other", Amount: "1"},
+				{Denom: "bar", Amount: "1"},
+			},
+			exp: []sdk.Coin{
+				sdk.NewCoin("bar", sdkmath.NewInt(1)),
+				sdk.NewCoin("foo", sdkmath.NewInt(1)),
+				sdk.NewCoin("other", sdkmath.NewInt(1)),
+			},
+		},
+		"zero amounts dropped": {
+			src: []wasmvmtypes.Coin{
+				{Denom: "foo", Amount: "1"},
+				{Denom: "bar", Amount: "0"},
+			},
+			exp: sdk.NewCoins(
+				sdk.NewCoin("foo", sdkmath.NewInt(1)),
+			),
+		},
+		"duplicate denoms merged": {
+			src: []wasmvmtypes.Coin{
+				{Denom: "foo", Amount: "1"},
+				{Denom: "foo", Amount: "1"},
+			},
+			exp: []sdk.Coin{sdk.NewCoin("foo", sdkmath.NewInt(2))},
+		},
+		"duplicate denoms with one 0 amount does not fail": {
 			src: []wasmvmtypes.Coin{
 				{Denom: "foo", Amount: "0"},
 				{Denom: "foo", Amount: "1"},
@@ -1102,3 +2901,51 @@ func TestConvertWasmCoinsToSdkCoins(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertWasmCoinsToSdkCoinsMatchesLegacy(t *testing.T) {
+	specs := map[string][]wasmvmtypes.Coin{
+		"nil":          nil,
+		"empty":        {},
+		"all zero":     {{Denom: "foo", Amount: "0"}, {Denom: "bar", Amount: "0"}},
+		"single":       {{Denom: "foo", Amount: "1"}},
+		"unsorted":     {{Denom: "foo", Amount: "1"}, {Denom: "bar", Amount: "2"}, {Denom: "baz", Amount: "3"}},
+		"duplicates":   {{Denom: "foo", Amount: "1"}, {Denom: "bar", Amount: "2"}, {Denom: "foo", Amount: "3"}, {Denom: "bar", Amount: "0"}},
+		"many coins":   benchmarkWasmCoins(200, 50),
+		"invalid":      {{Denom: "foo", Amount: "1"}, {Denom: "!%&", Amount: "1"}},
+		"invalid amnt": {{Denom: "foo", Amount: "1"}, {Denom: "bar", Amount: "x"}},
+	}
+	for name, src := range specs {
+		t.Run(name, func(t *testing.T) {
+			expCoins, expErr := legacyConvertWasmCoinsToSdkCoins(src)
+			gotCoins, gotErr := ConvertWasmCoinsToSdkCoins(src)
+			if expErr != nil {
+				assert.EqualError(t, gotErr, expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, expCoins, gotCoins)
+		})
+	}
+}
+
+// legacyConvertWasmCoinsToSdkCoins is the former implementation of ConvertWasmCoinsToSdkCoins
+func legacyConvertWasmCoinsToSdkCoins(coins []wasmvmtypes.Coin) (sdk.Coins, error) {
+	var toSend sdk.Coins
+	for _, coin := range coins {
+		c, err := ConvertWasmCoinToSdkCoin(coin)
+		if err != nil {
+			return nil, err
+		}
+		toSend = toSend.Add(c)
+	}
+	return toSend.Sort(), nil
+}
+
+// benchmarkWasmCoins returns n coins over the given number of denoms in unsorted order
+func benchmarkWasmCoins(n, denoms int) []wasmvmtypes.Coin {
+	r := make([]wasmvmtypes.Coin, n)
+	for i := range r {
+		r[i] = wasmvmtypes.NewCoin(uint64(i), fmt.Sprintf("denom%03d", (i*7)%denoms))
+	}
+	return r
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types" //nolint:staticcheck
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channelkeeperv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/keeper"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,6 +19,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -103,6 +107,308 @@ func TestMessageHandlerChainDispatch(t *testing.T) {
 	}
 }
 
+func TestCallDepthMessageHandlerGasCost(t *testing.T) {
+	capturingHandler, _ := wasmtesting.NewCapturingMessageHandler()
+	h := callDepthMessageHandler{Messenger: capturingHandler, MaxCallDepth: 10, DepthGasBase: 100, DepthGasPerLevel: 10}
+	wasmMsg := wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{ClearAdmin: &wasmvmtypes.ClearAdminMsg{}}}
+
+	var lastGas storetypes.Gas
+	for depth := uint32(0); depth < 5; depth++ {
+		ctx := types.WithCallDepth(sdk.Context{}.WithContext(context.Background()).WithGasMeter(storetypes.NewInfiniteGasMeter()), depth)
+		_, _, _, err := h.DispatchMsg(ctx, RandomAccountAddress(t), "", wasmMsg)
+		require.NoError(t, err)
+		gotGas := ctx.GasMeter().GasConsumed()
+		assert.Equal(t, 100+10*storetypes.Gas(depth+1), gotGas)
+		assert.Greater(t, gotGas, lastGas)
+		lastGas = gotGas
+	}
+
+	// non wasm messages are not charged
+	ctx := sdk.Context{}.WithContext(context.Background()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, _, _, err := h.DispatchMsg(ctx, RandomAccountAddress(t), "", wasmvmtypes.CosmosMsg{Custom: []byte(`{}`)})
+	require.NoError(t, err)
+	assert.Zero(t, ctx.GasMeter().GasConsumed())
+
+	// and no costs when not configured
+	h = callDepthMessageHandler{Messenger: capturingHandler, MaxCallDepth: 10}
+	ctx = types.WithCallDepth(sdk.Context{}.WithContext(context.Background()).WithGasMeter(storetypes.NewInfiniteGasMeter()), 3)
+	_, _, _, err = h.DispatchMsg(ctx, RandomAccountAddress(t), "", wasmMsg)
+	require.NoError(t, err)
+	assert.Zero(t, ctx.GasMeter().GasConsumed())
+}
+
+func TestClearAdminCheckMessageHandler(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	otherAddr := RandomAccountAddress(t)
+	targetAddr := RandomBech32AccountAddress(t)
+	clearAdminMsg := wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{ClearAdmin: &wasmvmtypes.ClearAdminMsg{ContractAddr: targetAddr}}}
+	withAdmin := func(admin string) contractInfoSourceFn {
+		return func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+			info := types.ContractInfoFixture(func(i *types.ContractInfo) { i.Admin = admin })
+			return &info
+		}
+	}
+	specs := map[string]struct {
+		source     contractInfoSourceFn
+		msg        wasmvmtypes.CosmosMsg
+		expErr     error
+		expHandled bool
+	}{
+		"admin sender": {
+			source:     withAdmin(myContractAddr.String()),
+			msg:        clearAdminMsg,
+			expHandled: true,
+		},
+		"non admin sender": {
+			source: withAdmin(otherAddr.String()),
+			msg:    clearAdminMsg,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"no admin set": {
+			source: withAdmin(""),
+			msg:    clearAdminMsg,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			source: func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo { return nil },
+			msg:    clearAdminMsg,
+			expErr: types.ErrNoSuchContractFn(targetAddr),
+		},
+		"invalid contract address": {
+			source: withAdmin(myContractAddr.String()),
+			msg:    wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{ClearAdmin: &wasmvmtypes.ClearAdminMsg{ContractAddr: "invalid"}}},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"other message passed on": {
+			source:     withAdmin(otherAddr.String()),
+			msg:        wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{UpdateAdmin: &wasmvmtypes.UpdateAdminMsg{ContractAddr: targetAddr, Admin: targetAddr}}},
+			expHandled: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturingHandler, gotMsgs := wasmtesting.NewCapturingMessageHandler()
+			h := NewMessageHandlerChain(NewClearAdminCheckMessageHandler(spec.source), capturingHandler)
+			var ctx sdk.Context
+			// when
+			_, _, _, gotErr := h.DispatchMsg(ctx, myContractAddr, "", spec.msg)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			if spec.expHandled {
+				assert.Len(t, *gotMsgs, 1)
+			} else {
+				assert.Empty(t, *gotMsgs)
+			}
+		})
+	}
+}
+
+func TestUniqueLabelCheckMessageHandler(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	isLabelTaken := func(ctx sdk.Context, label string) bool {
+		var found bool
+		k.IterateContractInfo(ctx, func(_ sdk.AccAddress, info types.ContractInfo) bool {
+			found = info.Label == label
+			return found
+		})
+		return found
+	}
+	instantiate := func(label string) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Instantiate: &wasmvmtypes.InstantiateMsg{CodeID: example.CodeID, Msg: []byte(`{}`), Label: label}}}
+	}
+	instantiate2 := func(label string) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Instantiate2: &wasmvmtypes.Instantiate2Msg{CodeID: example.CodeID, Msg: []byte(`{}`), Label: label, Salt: []byte("salt")}}}
+	}
+	specs := map[string]struct {
+		msg    wasmvmtypes.CosmosMsg
+		expErr error
+	}{
+		"instantiate - unique label": {
+			msg: instantiate("unique"),
+		},
+		"instantiate - duplicate label": {
+			msg:    instantiate(example.Label),
+			expErr: types.ErrDuplicate,
+		},
+		"instantiate2 - unique label": {
+			msg: instantiate2("unique"),
+		},
+		"instantiate2 - duplicate label": {
+			msg:    instantiate2(example.Label),
+			expErr: types.ErrDuplicate,
+		},
+		"other wasm message passed on": {
+			msg: wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{ClearAdmin: &wasmvmtypes.ClearAdminMsg{ContractAddr: example.Contract.String()}}},
+		},
+		"other message passed on": {
+			msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{Amount: []wasmvmtypes.Coin{wasmvmtypes.NewCoin(100, "denom")}}}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			capturingHandler, gotMsgs := wasmtesting.NewCapturingMessageHandler()
+			h := NewMessageHandlerChain(NewUniqueLabelCheckMessageHandler(isLabelTaken), capturingHandler)
+			// when
+			_, _, _, gotErr := h.DispatchMsg(ctx, example.Contract, "", spec.msg)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, *gotMsgs)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Len(t, *gotMsgs, 1)
+		})
+	}
+}
+
+func TestSpendableBalanceCheckMessageHandler(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	sendMsg := func(amount ...wasmvmtypes.Coin) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: RandomBech32AccountAddress(t), Amount: amount}}}
+	}
+	specs := map[string]struct {
+		spendable  sdk.Coins
+		msg        wasmvmtypes.CosmosMsg
+		expErr     *errorsmod.Error
+		expHandled bool
+	}{
+		"spendable above send": {
+			spendable:  sdk.NewCoins(sdk.NewInt64Coin("denom", 101)),
+			msg:        sendMsg(wasmvmtypes.NewCoin(100, "denom")),
+			expHandled: true,
+		},
+		"spendable equals send": {
+			spendable:  sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			msg:        sendMsg(wasmvmtypes.NewCoin(100, "denom")),
+			expHandled: true,
+		},
+		"spendable below send": {
+			spendable: sdk.NewCoins(sdk.NewInt64Coin("denom", 99)),
+			msg:       sendMsg(wasmvmtypes.NewCoin(100, "denom")),
+			expErr:    sdkerrors.ErrInsufficientFunds,
+		},
+		"other denom spendable only": {
+			spendable: sdk.NewCoins(sdk.NewInt64Coin("other", 100)),
+			msg:       sendMsg(wasmvmtypes.NewCoin(1, "denom")),
+			expErr:    sdkerrors.ErrInsufficientFunds,
+		},
+		"other message passed on": {
+			msg:        wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{Amount: []wasmvmtypes.Coin{wasmvmtypes.NewCoin(100, "denom")}}}},
+			expHandled: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturingHandler, gotMsgs := wasmtesting.NewCapturingMessageHandler()
+			source := spendableCoinsSourceFn(func(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+				require.Equal(t, myContractAddr, addr)
+				return spec.spendable
+			})
+			h := NewMessageHandlerChain(NewSpendableBalanceCheckMessageHandler(source), capturingHandler)
+			var ctx sdk.Context
+			// when
+			_, _, _, gotErr := h.DispatchMsg(ctx, myContractAddr, "", spec.msg)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Contains(t, gotErr.Error(), spec.spendable.String())
+				assert.Empty(t, *gotMsgs)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Len(t, *gotMsgs, 1)
+		})
+	}
+}
+
+func TestCustomDataMessageHandler(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	encoder := func(sender sdk.AccAddress, msg json.RawMessage) ([]byte, error) {
+		require.Equal(t, myContractAddr, sender)
+		var req struct {
+			Record *struct {
+				Data []byte `json:"data"`
+			} `json:"record,omitempty"`
+		}
+		if err := json.Unmarshal(msg, &req); err != nil || req.Record == nil {
+			return nil, types.ErrUnknownMsg
+		}
+		return req.Record.Data, nil
+	}
+	specs := map[string]struct {
+		msg          wasmvmtypes.CosmosMsg
+		expReplyData []byte
+		expHandled   bool
+	}{
+		"record data": {
+			msg:          wasmvmtypes.CosmosMsg{Custom: []byte(`{"record":{"data":"bXkgZGF0YQ=="}}`)},
+			expReplyData: []byte("my data"),
+		},
+		"other custom message passed on": {
+			msg:        wasmvmtypes.CosmosMsg{Custom: []byte(`{"foo":{}}`)},
+			expHandled: true,
+		},
+		"other message passed on": {
+			msg:        wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: RandomBech32AccountAddress(t)}}},
+			expHandled: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturingHandler, gotMsgs := wasmtesting.NewCapturingMessageHandler()
+			var gotReply *wasmvmtypes.Reply
+			replyer := &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					gotReply = &reply
+					return nil, nil
+				},
+			}
+			h := NewMessageHandlerChain(NewCustomDataMessageHandler(encoder), capturingHandler)
+			var mockStore wasmtesting.MockCommitMultiStore
+			ctx := sdk.Context{}.WithMultiStore(&mockStore).
+				WithGasMeter(storetypes.NewGasMeter(100)).
+				WithEventManager(sdk.NewEventManager()).WithLogger(log.NewTestLogger(t))
+			d := NewMessageDispatcher(h, replyer)
+			msgs := []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplySuccess, Msg: spec.msg}}
+
+			// when
+			_, gotErr := d.DispatchSubmessages(ctx, myContractAddr, "any_port", msgs)
+
+			// then
+			require.NoError(t, gotErr)
+			require.NotNil(t, gotReply)
+			require.NotNil(t, gotReply.Result.Ok)
+			if spec.expHandled {
+				assert.Len(t, *gotMsgs, 1)
+				return
+			}
+			assert.Empty(t, *gotMsgs)
+			assert.Equal(t, spec.expReplyData, gotReply.Result.Ok.Data)
+			assert.Empty(t, gotReply.Result.Ok.MsgResponses)
+		})
+	}
+}
+
+type spendableCoinsSourceFn func(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+
+func (f spendableCoinsSourceFn) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return f(ctx, addr)
+}
+
+type contractInfoSourceFn func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo
+
+func (f contractInfoSourceFn) GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+	return f(ctx, contractAddress)
+}
+
 func TestSDKMessageHandlerDispatch(t *testing.T) {
 	myEvent := sdk.NewEvent("myEvent", sdk.NewAttribute("foo", "bar"))
 	const myData = "myData"
@@ -361,6 +667,118 @@ func TestIBCRawPacketHandler(t *testing.T) {
 	}
 }
 
+var _ types.ChannelKeeperV2 = (*channelkeeperv2.Keeper)(nil)
+
+func TestIBC2WriteAckHandler(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	asyncPacket := channeltypesv2.Packet{
+		Sequence:          1,
+		SourceClient:      "07-tendermint-1",
+		DestinationClient: "07-tendermint-0",
+		Payloads:          []channeltypesv2.Payload{{SourcePort: "src-port", DestinationPort: PortIDForContractV2(myContractAddr)}},
+	}
+	otherPacket := channeltypesv2.Packet{
+		Sequence:          2,
+		SourceClient:      "07-tendermint-1",
+		DestinationClient: "07-tendermint-0",
+		Payloads:          []channeltypesv2.Payload{{SourcePort: "src-port", DestinationPort: PortIDForContractV2(RandomAccountAddress(t))}},
+	}
+	writeAckMsg := func(seq uint64, data []byte) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{IBC2: &wasmvmtypes.IBC2Msg{WriteAcknowledgement: &wasmvmtypes.IBC2WriteAcknowledgementMsg{
+			Ack:            wasmvmtypes.IBCAcknowledgement{Data: data},
+			SourceClient:   "07-tendermint-0",
+			PacketSequence: seq,
+		}}}
+	}
+	specs := map[string]struct {
+		msg    wasmvmtypes.CosmosMsg
+		expAck *channeltypesv2.Acknowledgement
+		expErr *errorsmod.Error
+	}{
+		"success ack": {
+			msg:    writeAckMsg(1, []byte(`{"result":"b2s="}`)),
+			expAck: &channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{[]byte(`{"result":"b2s="}`)}},
+		},
+		"error ack": {
+			msg:    writeAckMsg(1, []byte(`{"error":"my error"}`)),
+			expAck: &channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{channeltypesv2.ErrorAcknowledgement[:]}},
+		},
+		"both set": {
+			msg:    writeAckMsg(1, []byte(`{"result":"b2s=","error":"my error"}`)),
+			expErr: channeltypesv2.ErrInvalidAcknowledgement,
+		},
+		"unknown packet": {
+			msg:    writeAckMsg(3, []byte("ack")),
+			expErr: types.ErrInvalid,
+		},
+		"packet of other contract": {
+			msg:    writeAckMsg(2, []byte("ack")),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"other message passed on": {
+			msg:    wasmvmtypes.CosmosMsg{IBC2: &wasmvmtypes.IBC2Msg{SendPacket: &wasmvmtypes.IBC2SendPacketMsg{}}},
+			expErr: types.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotAck *channeltypesv2.Acknowledgement
+			channelKeeper := &mockChannelKeeperV2{
+				GetAsyncPacketFn: func(ctx sdk.Context, clientID string, sequence uint64) (channeltypesv2.Packet, bool) {
+					require.Equal(t, "07-tendermint-0", clientID)
+					switch sequence {
+					case asyncPacket.Sequence:
+						return asyncPacket, true
+					case otherPacket.Sequence:
+						return otherPacket, true
+					}
+					return channeltypesv2.Packet{}, false
+				},
+				WriteAcknowledgementFn: func(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error {
+					gotAck = &ack
+					return nil
+				},
+			}
+			h := NewIBC2WriteAckHandler(channelKeeper)
+			var ctx sdk.Context
+
+			// when
+			_, data, msgResponses, gotErr := h.DispatchMsg(ctx, myContractAddr, "", spec.msg)
+
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				assert.Nil(t, gotAck)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expAck, gotAck)
+			require.Len(t, data, 1)
+			require.Len(t, msgResponses, 1)
+			assert.Equal(t, "/"+proto.MessageName(&types.MsgIBCWriteAcknowledgementResponse{}), msgResponses[0][0].TypeUrl)
+		})
+	}
+}
+
+type mockChannelKeeperV2 struct {
+	GetAsyncPacketFn       func(ctx sdk.Context, clientID string, sequence uint64) (channeltypesv2.Packet, bool)
+	WriteAcknowledgementFn func(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error
+}
+
+func (m *mockChannelKeeperV2) GetAsyncPacket(ctx sdk.Context, clientID string, sequence uint64) (channeltypesv2.Packet, bool) {
+	if m.GetAsyncPacketFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetAsyncPacketFn(ctx, clientID, sequence)
+}
+
+func (m *mockChannelKeeperV2) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error {
+	if m.WriteAcknowledgementFn == nil {
+		panic("not expected to be called")
+	}
+	return m.WriteAcknowledgementFn(ctx, clientID, sequence, ack)
+}
+
 func TestBurnCoinMessageHandlerIntegration(t *testing.T) {
 	// testing via full keeper setup so that we are confident the
 	// module permissions are set correct and no other handler
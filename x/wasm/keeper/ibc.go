@@ -10,18 +10,35 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
+// portIDPrefix is the default prefix of the IBC port ids that are bound to contracts.
+// Chains can set a custom prefix with the WithPortIDPrefix keeper option.
 const portIDPrefix = "wasm."
 
+// PortIDForContract returns the IBC port id of the contract with the default prefix
 func PortIDForContract(addr sdk.AccAddress) string {
-	return portIDPrefix + addr.String()
+	return portIDForContract(portIDPrefix, addr)
 }
 
+// ContractFromPortID returns the contract address for an IBC port id with the default prefix
 func ContractFromPortID(portID string) (sdk.AccAddress, error) {
-	if !strings.HasPrefix(portID, portIDPrefix) {
+	return contractFromPortID(portIDPrefix, portID)
+}
+
+// PortIDForContract returns the IBC port id of the contract with the prefix of this keeper
+func (k Keeper) PortIDForContract(addr sdk.AccAddress) string {
+	return portIDForContract(k.portIDPrefix, addr)
+}
+
+func portIDForContract(prefix string, addr sdk.AccAddress) string {
+	return prefix + addr.String()
+}
+
+func contractFromPortID(prefix, portID string) (sdk.AccAddress, error) {
+	if !strings.HasPrefix(portID, prefix) {
 		return nil, errorsmod.Wrapf(types.ErrInvalid, "without prefix")
 	}
 
-	return sdk.AccAddressFromBech32(portID[len(portIDPrefix):])
+	return sdk.AccAddressFromBech32(portID[len(prefix):])
 }
 
 // The port prefix refers to "CosmWasm over IBC v2" and ensures packets are routed to the right entry points
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -50,3 +52,49 @@ func TestContractFromPortID(t *testing.T) {
 		})
 	}
 }
+
+func TestPortIDForContractWithPrefix(t *testing.T) {
+	specs := map[string]struct {
+		opts      []Option
+		expPrefix string
+	}{
+		"default prefix": {
+			expPrefix: "wasm.",
+		},
+		"custom prefix": {
+			opts:      []Option{WithPortIDPrefix("myapp.")},
+			expPrefix: "myapp.",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, spec.opts...)
+			k := keepers.WasmKeeper
+
+			// when
+			example := InstantiateIBCReflectContract(t, ctx, keepers)
+
+			// then
+			expPort := spec.expPrefix + example.Contract.String()
+			assert.Equal(t, expPort, k.PortIDForContract(example.Contract))
+			assert.Equal(t, expPort, k.GetContractInfo(ctx, example.Contract).IBCPortID)
+			gotAddr, ok := k.ContractByPortID(ctx, expPort)
+			require.True(t, ok)
+			assert.Equal(t, example.Contract, gotAddr)
+			// and the default encoder uses the prefix
+			encoders := k.messenger.(callDepthMessageHandler).Messenger.(*MessageHandlerChain).handlers[0].(SDKMessageHandler).encoders
+			gotMsgs, err := encoders.Encode(ctx, example.Contract, expPort, wasmvmtypes.CosmosMsg{
+				IBC: &wasmvmtypes.IBCMsg{CloseChannel: &wasmvmtypes.CloseChannelMsg{ChannelID: "channel-1"}},
+			})
+			require.NoError(t, err)
+			require.Len(t, gotMsgs, 1)
+			assert.Equal(t, expPort, gotMsgs[0].(*channeltypes.MsgChannelCloseInit).PortId)
+		})
+	}
+}
+
+func TestWithPortIDPrefixRejectsInvalidPrefix(t *testing.T) {
+	for _, prefix := range []string{"", "a", "my/app."} {
+		assert.Panics(t, func() { WithPortIDPrefix(prefix) }, prefix)
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -15,12 +17,14 @@ import (
 	wasmvm "github.com/CosmWasm/wasmvm/v3"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
 
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -77,6 +81,38 @@ type WasmVMResponseHandler interface {
 	) ([]byte, error)
 }
 
+// PreExecuteHook is an extension point to inspect the raw message of a contract execution before the
+// contract is called. A returned error aborts the execution.
+type PreExecuteHook interface {
+	PreExecute(ctx context.Context, contractAddr, caller sdk.AccAddress, msg []byte, funds sdk.Coins) error
+}
+
+var _ PreExecuteHook = PreExecuteHookFn(nil)
+
+// PreExecuteHookFn is a helper to construct a function based pre execute hook.
+type PreExecuteHookFn func(ctx context.Context, contractAddr, caller sdk.AccAddress, msg []byte, funds sdk.Coins) error
+
+// PreExecute delegates call into wrapped PreExecuteHookFn
+func (h PreExecuteHookFn) PreExecute(ctx context.Context, contractAddr, caller sdk.AccAddress, msg []byte, funds sdk.Coins) error {
+	return h(ctx, contractAddr, caller, msg, funds)
+}
+
+// MigrationHook is an extension point that is notified after a contract was migrated successfully.
+// A returned error aborts the migration only when the keeper is set up with WithMigrationHookErrorsAbort.
+type MigrationHook interface {
+	AfterContractMigrated(ctx context.Context, contractAddr sdk.AccAddress, fromCodeID, toCodeID uint64) error
+}
+
+var _ MigrationHook = MigrationHookFn(nil)
+
+// MigrationHookFn is a helper to construct a function based migration hook.
+type MigrationHookFn func(ctx context.Context, contractAddr sdk.AccAddress, fromCodeID, toCodeID uint64) error
+
+// AfterContractMigrated delegates call into wrapped MigrationHookFn
+func (h MigrationHookFn) AfterContractMigrated(ctx context.Context, contractAddr sdk.AccAddress, fromCodeID, toCodeID uint64) error {
+	return h(ctx, contractAddr, fromCodeID, toCodeID)
+}
+
 // list of account types that are accepted for wasm contracts. Chains importing wasmd
 // can overwrite this list with the WithAcceptedAccountTypesOnContractInstantiation option.
 var defaultAcceptedAccountTypes = map[reflect.Type]struct{}{
@@ -90,20 +126,42 @@ type Keeper struct {
 	cdc                   codec.Codec
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
+	bankView              types.BankViewKeeper
+	channelKeeper         types.ChannelKeeper
+	stakingKeeper         types.StakingKeeper
 	wasmVM                types.WasmEngine
 	wasmVMQueryHandler    WasmVMQueryHandler
 	wasmVMResponseHandler WasmVMResponseHandler
 	messenger             Messenger
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
-	queryGasLimit        uint64
-	gasRegister          types.GasRegister
-	maxQueryStackSize    uint32
-	maxCallDepth         uint32
+	queryGasLimit     uint64
+	gasRegister       types.GasRegister
+	maxQueryStackSize uint32
+	maxCallDepth      uint32
+	// maxContractMessages is the max number of messages in a single contract response
+	maxContractMessages uint32
+	// replyGasLimit is the max sdk gas that a single reply call can consume, zero for no limit
+	replyGasLimit uint64
+	// gas charged for dispatched wasm messages, increasing with the call depth
+	callDepthGasBase     uint64
+	callDepthGasPerLevel uint64
 	acceptedAccountTypes map[reflect.Type]struct{}
 	accountPruner        AccountPruner
 	params               collections.Item[types.Params]
+	// decides which submessage events are passed to the contract with the reply
+	replyEventsFilter ReplyEventsFilter
+	// replaces the reply data of IBC transfer submessages with the packet sequence and channel
+	ibcTransferReplyData bool
+	// replaces the reply data of undelegate submessages with the unbonding completion time
+	undelegateReplyData bool
 	// propagate gov authZ to sub-messages
 	propagateGovAuthorization map[types.AuthorizationPolicyAction]struct{}
+	// run in order before a contract is executed
+	preExecuteHooks []PreExecuteHook
+	// run in order after a contract was migrated
+	migrationHooks []MigrationHook
+	// abort a migration when a migration hook fails
+	migrationHookErrorsAbort bool
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -112,6 +170,9 @@ type Keeper struct {
 	// wasmLimits contains the limits sent to wasmvm on init
 	wasmLimits wasmvmtypes.WasmLimits
 
+	// prefix of the IBC port ids that are bound to contracts
+	portIDPrefix string
+
 	ibcRouterV2 *ibcapi.Router
 }
 
@@ -159,6 +220,9 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 	if creator == nil {
 		return 0, checksum, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot be nil")
 	}
+	if k.IsUploadFrozen(ctx) {
+		return 0, checksum, types.ErrUploadFrozen
+	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	// figure out proper instantiate access
 	defaultAccessConfig := k.getInstantiateAccessConfig(sdkCtx).With(creator)
@@ -204,10 +268,30 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 		}
 		requiredCapabilities = report.RequiredCapabilities
 	}
+	if k.GetParams(sdkCtx).CodeDeduplication {
+		if existingID, found := k.findCodeByChecksum(sdkCtx, checksum, *instantiateAccess); found {
+			k.Logger(sdkCtx).Debug("skip storing duplicate contract", "code_id", existingID)
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeStoreCode,
+				sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(checksum)),
+				sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(existingID, 10)),
+			))
+			return existingID, checksum, nil
+		}
+	}
 	codeID = k.mustAutoIncrementID(sdkCtx, types.KeySequenceCodeID)
 	k.Logger(sdkCtx).Debug("storing new contract", "capabilities", requiredCapabilities, "code_id", codeID)
 	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
 	k.mustStoreCodeInfo(sdkCtx, codeID, codeInfo)
+	if err := k.addToCodeCreatorSecondaryIndex(sdkCtx, creator, codeID); err != nil {
+		return 0, checksum, err
+	}
+	if err := k.addToCodeChecksumSecondaryIndex(sdkCtx, checksum, codeID); err != nil {
+		return 0, checksum, err
+	}
+	if err := k.addToCount(sdkCtx, types.KeyCodeCount, 1); err != nil {
+		return 0, checksum, err
+	}
 
 	evt := sdk.NewEvent(
 		types.EventTypeStoreCode,
@@ -222,6 +306,22 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 	return codeID, checksum, nil
 }
 
+// findCodeByChecksum returns the first code id with the given checksum and instantiate config.
+// Only the codes with the given checksum are visited via the checksum secondary index.
+func (k Keeper) findCodeByChecksum(ctx context.Context, checksum []byte, instantiateAccess types.AccessConfig) (uint64, bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetCodesByChecksumPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		codeID := sdk.BigEndianToUint64(iter.Key())
+		info := k.GetCodeInfo(ctx, codeID)
+		if info != nil && info.InstantiateConfig.Equals(instantiateAccess) {
+			return codeID, true
+		}
+	}
+	return 0, false
+}
+
 func (k Keeper) mustStoreCodeInfo(ctx context.Context, codeID uint64, codeInfo types.CodeInfo) {
 	store := k.storeService.OpenKVStore(ctx)
 	// 0x01 | codeID (uint64) -> ContractInfo
@@ -257,7 +357,67 @@ func (k Keeper) importCode(ctx context.Context, codeID uint64, codeInfo types.Co
 		return errorsmod.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
-	return store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	if err := store.Set(key, k.cdc.MustMarshal(&codeInfo)); err != nil {
+		return err
+	}
+	creator, err := sdk.AccAddressFromBech32(codeInfo.Creator)
+	if err != nil {
+		return errorsmod.Wrap(err, "creator")
+	}
+	if err := k.addToCodeCreatorSecondaryIndex(ctx, creator, codeID); err != nil {
+		return err
+	}
+	if err := k.addToCodeChecksumSecondaryIndex(ctx, codeInfo.CodeHash, codeID); err != nil {
+		return err
+	}
+	return k.addToCount(ctx, types.KeyCodeCount, 1)
+}
+
+// importCodeSettings restores the gas limit, sudo allow list and metadata of an imported code
+func (k Keeper) importCodeSettings(ctx context.Context, code types.Code) error {
+	if code.GasLimit != 0 {
+		if err := k.SetCodeGasLimit(ctx, k.authority, code.CodeID, code.GasLimit); err != nil {
+			return errorsmod.Wrap(err, "gas limit")
+		}
+	}
+	if len(code.SudoAllowList) != 0 {
+		if err := k.SetSudoAllowList(ctx, k.authority, code.CodeID, code.SudoAllowList); err != nil {
+			return errorsmod.Wrap(err, "sudo allow list")
+		}
+	}
+	if len(code.Metadata) != 0 {
+		if err := k.storeService.OpenKVStore(ctx).Set(types.GetCodeMetadataKey(code.CodeID), code.Metadata); err != nil {
+			return errorsmod.Wrap(err, "metadata")
+		}
+	}
+	return nil
+}
+
+// addToCodeCreatorSecondaryIndex adds an entry to the code by creator index
+func (k Keeper) addToCodeCreatorSecondaryIndex(ctx context.Context, creator sdk.AccAddress, codeID uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetCodeByCreatorSecondaryIndexKey(creator, codeID), []byte{})
+}
+
+// addToCodeChecksumSecondaryIndex adds an entry to the checksum index that is used to find duplicate uploads
+func (k Keeper) addToCodeChecksumSecondaryIndex(ctx context.Context, checksum []byte, codeID uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetCodeByChecksumSecondaryIndexKey(checksum, codeID), []byte{})
+}
+
+// CodesByCreator returns the ids of all codes uploaded by the given creator in ascending order
+func (k Keeper) CodesByCreator(ctx context.Context, creator sdk.AccAddress) ([]uint64, error) {
+	if err := sdk.VerifyAddressFormat(creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetCodesByCreatorPrefix(creator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	codeIDs := make([]uint64, 0)
+	for ; iter.Valid(); iter.Next() {
+		codeIDs = append(codeIDs, sdk.BigEndianToUint64(iter.Key()))
+	}
+	return codeIDs, nil
 }
 
 func (k Keeper) instantiate(
@@ -350,10 +510,13 @@ func (k Keeper) instantiate(
 	querier := k.newQueryHandler(sdkCtx, contractAddress)
 
 	// instantiate wasm contract
-	gasLeft := k.runtimeGasForContract(sdkCtx)
+	gasLeft, codeGasLimit := k.runtimeGasForCode(sdkCtx, codeID)
 	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, vmStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
 	if err != nil {
+		if codeGasLimit != 0 && gasUsed >= codeGasLimit {
+			return nil, nil, errorsmod.Wrapf(types.ErrCodeGasLimit, "code id %d", codeID)
+		}
 		return nil, nil, errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
 	if res == nil {
@@ -375,7 +538,7 @@ func (k Keeper) instantiate(
 	}
 	if report.HasIBCEntryPoints {
 		// register IBC port
-		ibcPort := PortIDForContract(contractAddress)
+		ibcPort := k.PortIDForContract(contractAddress)
 		contractInfo.IBCPortID = ibcPort
 	}
 	if report.HasIBC2EntryPoints {
@@ -395,10 +558,22 @@ func (k Keeper) instantiate(
 	if err != nil {
 		return nil, nil, err
 	}
+	err = k.addToContractHeightSecondaryIndex(sdkCtx, historyEntry.Updated, contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	if admin != nil {
+		if err := k.addToContractAdminSecondaryIndex(sdkCtx, admin, contractAddress); err != nil {
+			return nil, nil, err
+		}
+	}
 	err = k.appendToContractHistory(sdkCtx, contractAddress, historyEntry)
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := k.addToCount(sdkCtx, types.KeyContractCount, 1); err != nil {
+		return nil, nil, err
+	}
 
 	k.mustStoreContractInfo(sdkCtx, contractAddress, &contractInfo)
 
@@ -420,11 +595,19 @@ func (k Keeper) instantiate(
 // Execute executes the contract instance
 func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
+	for _, h := range k.preExecuteHooks {
+		if err := h.PreExecute(ctx, contractAddress, caller, msg, coins); err != nil {
+			return nil, err
+		}
+	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.checkContractNotPaused(sdkCtx, contractAddress); err != nil {
+		return nil, err
+	}
 
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
 	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
@@ -443,10 +626,13 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 
 	// prepare querier
 	querier := k.newQueryHandler(sdkCtx, contractAddress)
-	gasLeft := k.runtimeGasForContract(sdkCtx)
+	gasLeft, codeGasLimit := k.runtimeGasForCode(sdkCtx, contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
 	if execErr != nil {
+		if codeGasLimit != 0 && gasUsed >= codeGasLimit {
+			return nil, errorsmod.Wrapf(types.ErrCodeGasLimit, "code id %d", contractInfo.CodeID)
+		}
 		return nil, errorsmod.Wrap(types.ErrVMError, execErr.Error())
 	}
 	if res == nil {
@@ -470,6 +656,29 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 	return data, nil
 }
 
+// ExecuteWithGasMeter executes the contract instance like execute but charges all gas to the given meter
+// instead of the context's one, which is not modified. This allows tooling to run an execution with
+// a specific gas budget and read back the exact consumption.
+// Running out of gas on the given meter is returned as an error. State changes and events are only
+// committed to the given context when the execution succeeds.
+func (k Keeper) ExecuteWithGasMeter(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, meter storetypes.GasMeter) (data []byte, err error) {
+	cacheCtx, commit := sdk.UnwrapSDKContext(ctx).WithGasMeter(meter).CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			data, err = nil, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "execute with gas meter")
+		}
+	}()
+	data, err = k.execute(cacheCtx, contractAddress, caller, msg, coins)
+	if err != nil {
+		return nil, err
+	}
+	commit()
+	return data, nil
+}
+
 func (k Keeper) migrate(
 	ctx context.Context,
 	contractAddress sdk.AccAddress,
@@ -489,6 +698,9 @@ func (k Keeper) migrate(
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
 	}
+	if err := k.checkContractNotPaused(sdkCtx, contractAddress); err != nil {
+		return nil, err
+	}
 
 	newCodeInfo := k.GetCodeInfo(ctx, newCodeID)
 	if newCodeInfo == nil {
@@ -509,14 +721,15 @@ func (k Keeper) migrate(
 		return nil, errorsmod.Wrap(types.ErrMigrationFailed, "requires ibc callbacks")
 	case report.HasIBCEntryPoints && contractInfo.IBCPortID == "":
 		// add ibc port
-		ibcPort := PortIDForContract(contractAddress)
+		ibcPort := k.PortIDForContract(contractAddress)
 		contractInfo.IBCPortID = ibcPort
 	}
 
 	var response *wasmvmtypes.Response
 
 	// check for migrate version
-	oldCodeInfo := k.GetCodeInfo(ctx, contractInfo.CodeID)
+	oldCodeID := contractInfo.CodeID
+	oldCodeInfo := k.GetCodeInfo(ctx, oldCodeID)
 	oldReport, err := k.wasmVM.AnalyzeCode(oldCodeInfo.CodeHash)
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, err.Error())
@@ -579,9 +792,16 @@ func (k Keeper) migrate(
 		if err != nil {
 			return nil, errorsmod.Wrap(err, "dispatch")
 		}
-		return data, nil
 	}
 
+	for _, h := range k.migrationHooks {
+		if err := h.AfterContractMigrated(ctx, contractAddress, oldCodeID, newCodeID); err != nil {
+			if k.migrationHookErrorsAbort {
+				return nil, errorsmod.Wrap(err, "migration hook")
+			}
+			k.Logger(sdkCtx).Error("migration hook failed", "contract", contractAddress.String(), "error", err)
+		}
+	}
 	return data, nil
 }
 
@@ -643,6 +863,12 @@ func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []
 		return nil, err
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkContractNotPaused(sdkCtx, contractAddress); err != nil {
+		return nil, err
+	}
+	if err := k.checkSudoAllowed(sdkCtx, contractInfo.CodeID, msg); err != nil {
+		return nil, err
+	}
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
 	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
 
@@ -680,6 +906,41 @@ func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []
 	return data, nil
 }
 
+// SudoDryRun executes the sudo call in a cached context and discards all state changes. The data and the events
+// that would have been emitted are returned so that privileged calls can be reviewed before they are executed.
+func (k Keeper) SudoDryRun(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, sdk.Events, error) {
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	em := sdk.NewEventManager()
+	data, err := k.Sudo(cacheCtx.WithEventManager(em), contractAddress, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, em.Events(), nil
+}
+
+// SudoCall is a single privileged call that is executed as part of a SudoMulti batch.
+type SudoCall struct {
+	Contract sdk.AccAddress
+	Msg      []byte
+}
+
+// SudoMulti executes the sudo calls in sequence within one cached context. State changes and events are only
+// committed when all calls succeed, otherwise everything is rolled back. Like Sudo, the keeper doesn't place any
+// access controls on it: it is meant to be invoked by on-chain governance only.
+func (k Keeper) SudoMulti(ctx context.Context, calls []SudoCall) error {
+	if len(calls) == 0 {
+		return errorsmod.Wrap(types.ErrEmpty, "sudo calls")
+	}
+	cacheCtx, commit := sdk.UnwrapSDKContext(ctx).CacheContext()
+	for i, c := range calls {
+		if _, err := k.Sudo(cacheCtx, c.Contract, c.Msg); err != nil {
+			return errorsmod.Wrapf(err, "call %d", i)
+		}
+	}
+	commit()
+	return nil
+}
+
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
 func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
@@ -694,11 +955,14 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
-	gasLeft := k.runtimeGasForContract(ctx)
+	gasLeft, replyGasLimit := k.runtimeGasForReply(ctx)
 
 	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
+		if replyGasLimit != 0 && gasUsed >= replyGasLimit {
+			return nil, errorsmod.Wrapf(types.ErrReplyGasLimit, "contract %s", contractAddress)
+		}
 		return nil, errorsmod.Wrap(types.ErrVMError, execErr.Error())
 	}
 	if res == nil {
@@ -764,6 +1028,59 @@ func (k Keeper) IterateContractsByCode(ctx context.Context, codeID uint64, cb fu
 	}
 }
 
+// addToContractHeightSecondaryIndex adds an entry to the contract by instantiation height index
+func (k Keeper) addToContractHeightSecondaryIndex(ctx context.Context, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetContractByHeightSecondaryIndexKey(position.BlockHeight, contractAddress), []byte{})
+}
+
+// ContractsInstantiatedBetween returns the addresses of all contracts instantiated within the given block height
+// range, including both bounds. The result is ordered by height and contract address.
+func (k Keeper) ContractsInstantiatedBetween(ctx context.Context, fromHeight, toHeight uint64) ([]sdk.AccAddress, error) {
+	if fromHeight > toHeight {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "from height %d > to height %d", fromHeight, toHeight)
+	}
+	var end []byte
+	if toHeight != math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(toHeight + 1)
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ContractsByHeightPrefix)
+	iter := prefixStore.Iterator(sdk.Uint64ToBigEndian(fromHeight), end)
+	defer iter.Close()
+	contracts := make([]sdk.AccAddress, 0)
+	for ; iter.Valid(); iter.Next() {
+		contracts = append(contracts, sdk.AccAddress(bytes.Clone(iter.Key()[8:])))
+	}
+	return contracts, nil
+}
+
+// addToContractAdminSecondaryIndex adds an entry to the contract by admin index
+func (k Keeper) addToContractAdminSecondaryIndex(ctx context.Context, admin, contractAddress sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetContractByAdminSecondaryIndexKey(admin, contractAddress), []byte{})
+}
+
+// removeFromContractAdminSecondaryIndex removes an entry from the contract by admin index
+func (k Keeper) removeFromContractAdminSecondaryIndex(ctx context.Context, admin, contractAddress sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetContractByAdminSecondaryIndexKey(admin, contractAddress))
+}
+
+// ContractsByAdmin returns the addresses of all contracts administered by the given admin, ordered by address
+func (k Keeper) ContractsByAdmin(ctx context.Context, admin sdk.AccAddress) ([]sdk.AccAddress, error) {
+	if err := sdk.VerifyAddressFormat(admin); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractsByAdminPrefix(admin))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	contracts := make([]sdk.AccAddress, 0)
+	for ; iter.Valid(); iter.Next() {
+		contracts = append(contracts, sdk.AccAddress(bytes.Clone(iter.Key())))
+	}
+	return contracts, nil
+}
+
 func (k Keeper) setContractAdmin(ctx context.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ types.AuthorizationPolicy) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
@@ -773,6 +1090,16 @@ func (k Keeper) setContractAdmin(ctx context.Context, contractAddress, caller, n
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	if oldAdmin := contractInfo.AdminAddr(); oldAdmin != nil {
+		if err := k.removeFromContractAdminSecondaryIndex(sdkCtx, oldAdmin, contractAddress); err != nil {
+			return err
+		}
+	}
+	if len(newAdmin) != 0 {
+		if err := k.addToContractAdminSecondaryIndex(sdkCtx, newAdmin, contractAddress); err != nil {
+			return err
+		}
+	}
 	newAdminStr := newAdmin.String()
 	contractInfo.Admin = newAdminStr
 	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
@@ -830,7 +1157,14 @@ func (k Keeper) appendToContractHistory(ctx context.Context, contractAddr sdk.Ac
 	return nil
 }
 
+// GetContractHistory returns the code history entries of the given contract in order.
 func (k Keeper) GetContractHistory(ctx context.Context, contractAddr sdk.AccAddress) []types.ContractCodeHistoryEntry {
+	return k.GetContractHistoryByOperation(ctx, contractAddr)
+}
+
+// GetContractHistoryByOperation returns the code history entries of the given contract in order.
+// When operation types are given, only entries with one of these operations are returned.
+func (k Keeper) GetContractHistoryByOperation(ctx context.Context, contractAddr sdk.AccAddress, ops ...types.ContractCodeHistoryOperationType) []types.ContractCodeHistoryEntry {
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractCodeHistoryElementPrefix(contractAddr))
 	r := make([]types.ContractCodeHistoryEntry, 0)
 	iter := prefixStore.Iterator(nil, nil)
@@ -843,6 +1177,9 @@ func (k Keeper) GetContractHistory(ctx context.Context, contractAddr sdk.AccAddr
 
 		var e types.ContractCodeHistoryEntry
 		k.cdc.MustUnmarshal(iter.Value(), &e)
+		if len(ops) != 0 && !slices.Contains(ops, e.Operation) {
+			continue
+		}
 		r = append(r, e)
 	}
 	return r
@@ -899,6 +1236,17 @@ func (k Keeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req
 	return queryResult.Ok, nil
 }
 
+// QueryWithGas executes a smart query like QuerySmart with a child gas meter and returns the gas consumed by it.
+// The gas used is charged to the gas meter of the given context, too.
+func (k Keeper) QueryWithGas(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasMeter := storetypes.NewGasMeter(sdkCtx.GasMeter().GasRemaining())
+	data, err := k.QuerySmart(sdkCtx.WithGasMeter(gasMeter), contractAddr, req)
+	gasUsed := gasMeter.GasConsumedToLimit()
+	sdkCtx.GasMeter().ConsumeGas(gasUsed, "smart query")
+	return data, gasUsed, err
+}
+
 func checkAndIncreaseQueryStackSize(ctx context.Context, maxQueryStackSize uint32) (sdk.Context, error) {
 	var queryStackSize uint32 = 0
 	if size, ok := types.QueryStackSize(ctx); ok {
@@ -1081,6 +1429,80 @@ func (k Keeper) IterateContractState(ctx context.Context, contractAddress sdk.Ac
 	}
 }
 
+// ContractInfoWithBalances returns the contract info together with all balances of the contract.
+func (k Keeper) ContractInfoWithBalances(ctx context.Context, contractAddress sdk.AccAddress) (types.ContractInfo, sdk.Coins, error) {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return types.ContractInfo{}, nil, types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	return *contractInfo, k.bankView.GetAllBalances(ctx, contractAddress), nil
+}
+
+// EstimateStorageRent returns an estimate of the storage rent for a contract in the bond denom. The number of
+// key and value bytes in the contract's prefix store is multiplied by the given rate per byte.
+// This is a read-only estimator, no rent is charged.
+func (k Keeper) EstimateStorageRent(ctx context.Context, contractAddress sdk.AccAddress, perByte sdkmath.Int) (sdk.Coin, error) {
+	if perByte.IsNil() || perByte.IsNegative() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrInvalid, "rate per byte must not be negative")
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdk.Coin{}, types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "bond denom")
+	}
+	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	var size uint64
+	for ; iter.Valid(); iter.Next() {
+		size += uint64(len(iter.Key()) + len(iter.Value()))
+	}
+	return sdk.NewCoin(bondDenom, sdkmath.NewIntFromUint64(size).Mul(perByte)), nil
+}
+
+// ContractStateEntryCount returns the number of entries in the contract's prefix store.
+// Only the keys are touched, the values are not loaded where the underlying store supports this.
+func (k Keeper) ContractStateEntryCount(ctx context.Context, contractAddress sdk.AccAddress) (uint64, error) {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return 0, types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// PurgeContractState deletes all entries from the contract's prefix store.
+// The caller must be the module authority and the contract must not hold any funds.
+func (k Keeper) PurgeContractState(ctx context.Context, authority string, contractAddress sdk.AccAddress) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if balance := k.bankView.GetAllBalances(ctx, contractAddress); !balance.IsZero() {
+		return errorsmod.Wrapf(types.ErrInvalid, "contract holds funds: %s", balance)
+	}
+	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+	return nil
+}
+
 func (k Keeper) importContractState(ctx context.Context, contractAddress sdk.AccAddress, models []types.Model) error {
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
@@ -1280,6 +1702,19 @@ func (k Keeper) setAccessConfig(ctx context.Context, codeID uint64, caller sdk.A
 	return nil
 }
 
+// UpdateCodeInstantiatePermission updates the instantiate permission of an existing code.
+// The caller must be the code creator or the module authority.
+func (k Keeper) UpdateCodeInstantiatePermission(ctx context.Context, caller sdk.AccAddress, codeID uint64, newConfig types.AccessConfig) error {
+	if err := newConfig.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "instantiate permission")
+	}
+	var authz types.AuthorizationPolicy = DefaultAuthorizationPolicy{}
+	if caller.String() == k.authority {
+		authz = newGovAuthorizationPolicy(k.propagateGovAuthorization)
+	}
+	return k.setAccessConfig(ctx, codeID, caller, newConfig, authz)
+}
+
 // handleContractResponse processes the contract response data by emitting events and sending sub-/messages.
 func (k *Keeper) handleContractResponse(
 	ctx sdk.Context,
@@ -1321,6 +1756,162 @@ func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
 	return k.gasRegister.ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
 }
 
+// runtimeGasForCode returns the wasmvm gas available to a contract of the given code.
+// When a gas ceiling is set for the code and lower than the remaining gas, the ceiling is
+// returned as second value, in wasmvm gas. Otherwise, the second value is zero.
+func (k Keeper) runtimeGasForCode(ctx sdk.Context, codeID uint64) (uint64, uint64) {
+	gasLeft := k.runtimeGasForContract(ctx)
+	// the lookup is not charged so that codes without a ceiling keep their gas costs
+	limit := k.GetCodeGasLimit(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), codeID)
+	if limit == 0 {
+		return gasLeft, 0
+	}
+	if vmLimit := k.gasRegister.ToWasmVMGas(limit); vmLimit < gasLeft {
+		return vmLimit, vmLimit
+	}
+	return gasLeft, 0
+}
+
+// runtimeGasForReply returns the wasmvm gas available to a reply call. When the reply gas limit is set and
+// lower than the remaining gas, the limit is returned as second value, in wasmvm gas. Otherwise, the second
+// value is zero.
+func (k Keeper) runtimeGasForReply(ctx sdk.Context) (uint64, uint64) {
+	gasLeft := k.runtimeGasForContract(ctx)
+	if k.replyGasLimit == 0 {
+		return gasLeft, 0
+	}
+	if vmLimit := k.gasRegister.ToWasmVMGas(k.replyGasLimit); vmLimit < gasLeft {
+		return vmLimit, vmLimit
+	}
+	return gasLeft, 0
+}
+
+// SetCodeGasLimit sets the max gas that a single instantiate or execute call of a contract with the given code
+// can consume, independent of the tx gas limit. The limit is in sdk gas. A zero limit removes the ceiling.
+// The caller must be the module authority.
+func (k Keeper) SetCodeGasLimit(ctx context.Context, authority string, codeID uint64, limit uint64) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if !k.containsCodeInfo(ctx, codeID) {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if limit == 0 {
+		return store.Delete(types.GetCodeGasLimitKey(codeID))
+	}
+	return store.Set(types.GetCodeGasLimitKey(codeID), sdk.Uint64ToBigEndian(limit))
+}
+
+// GetCodeGasLimit returns the gas ceiling for the given code or zero when not set
+func (k Keeper) GetCodeGasLimit(ctx context.Context, codeID uint64) uint64 {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetCodeGasLimitKey(codeID))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetCodeMetadata attaches an opaque metadata blob, like a source url or build info, to the given code for provenance.
+// Only the code creator or the authority can set it. Empty metadata removes an existing entry.
+func (k Keeper) SetCodeMetadata(ctx context.Context, caller sdk.AccAddress, codeID uint64, metadata []byte) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	if caller.String() != codeInfo.Creator && caller.String() != k.authority {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not set code metadata")
+	}
+	if len(metadata) > types.MaxCodeMetadataSize {
+		return errorsmod.Wrapf(types.ErrLimit, "metadata cannot be longer than %d bytes", types.MaxCodeMetadataSize)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if len(metadata) == 0 {
+		return store.Delete(types.GetCodeMetadataKey(codeID))
+	}
+	return store.Set(types.GetCodeMetadataKey(codeID), metadata)
+}
+
+// GetCodeMetadata returns the metadata attached to the given code or nil when not set
+func (k Keeper) GetCodeMetadata(ctx context.Context, codeID uint64) []byte {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetCodeMetadataKey(codeID))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// SetSudoAllowList restricts the sudo messages for contracts of the given code to the given top level JSON keys.
+// An empty list removes the restriction so that all sudo messages are permitted.
+// The caller must be the module authority.
+func (k Keeper) SetSudoAllowList(ctx context.Context, authority string, codeID uint64, keys []string) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if !k.containsCodeInfo(ctx, codeID) {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	for _, key := range keys {
+		if key == "" {
+			return errorsmod.Wrap(types.ErrEmpty, "sudo message key")
+		}
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetSudoAllowListPrefix(codeID))
+	iter := prefixStore.Iterator(nil, nil)
+	var existing [][]byte
+	for ; iter.Valid(); iter.Next() {
+		existing = append(existing, iter.Key())
+	}
+	iter.Close()
+	for _, key := range existing {
+		prefixStore.Delete(key)
+	}
+	for _, key := range keys {
+		prefixStore.Set([]byte(key), []byte{1})
+	}
+	return nil
+}
+
+// GetSudoAllowList returns the allowed top level sudo message keys for the given code, sorted.
+// An empty result means that all sudo messages are permitted.
+func (k Keeper) GetSudoAllowList(ctx context.Context, codeID uint64) []string {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetSudoAllowListPrefix(codeID))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	return keys
+}
+
+// checkSudoAllowed returns an error when an allow list is set for the code and the top level
+// key of the sudo message is not on it
+func (k Keeper) checkSudoAllowed(ctx sdk.Context, codeID uint64, msg []byte) error {
+	// the lookup is not charged so that codes without an allow list keep their gas costs
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())))
+	prefixStore := prefix.NewStore(store, types.GetSudoAllowListPrefix(codeID))
+	iter := prefixStore.Iterator(nil, nil)
+	restricted := iter.Valid()
+	iter.Close()
+	if !restricted {
+		return nil
+	}
+	var parsed map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &parsed); err != nil || len(parsed) != 1 {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "sudo message must be an object with a single top level key")
+	}
+	for key := range parsed {
+		if !prefixStore.Has([]byte(key)) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "sudo message %q not allowed for code id %d", key, codeID)
+		}
+	}
+	return nil
+}
+
 func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
 	consumed := k.gasRegister.FromWasmVMGas(gas)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
@@ -1362,6 +1953,43 @@ func (k Keeper) PeekAutoIncrementID(ctx context.Context, sequenceKey []byte) (ui
 	return id, nil
 }
 
+// CodeCount returns the total number of stored codes without iterating the code infos.
+func (k Keeper) CodeCount(ctx context.Context) uint64 {
+	return k.getCount(ctx, types.KeyCodeCount)
+}
+
+// ContractCount returns the total number of contract instances without iterating the contract infos.
+func (k Keeper) ContractCount(ctx context.Context) uint64 {
+	return k.getCount(ctx, types.KeyContractCount)
+}
+
+func (k Keeper) getCount(ctx context.Context, countKey []byte) uint64 {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(countKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setCount overwrites the counter value. Used to rebuild the counters on migrations.
+func (k Keeper) setCount(ctx context.Context, countKey []byte, val uint64) error {
+	return k.storeService.OpenKVStore(ctx).Set(countKey, sdk.Uint64ToBigEndian(val))
+}
+
+func (k Keeper) addToCount(ctx context.Context, countKey []byte, delta int) error {
+	count := k.getCount(ctx, countKey)
+	if delta < 0 {
+		if count < uint64(-delta) {
+			return errorsmod.Wrapf(types.ErrInvalid, "counter %s underflow", string(countKey))
+		}
+		return k.setCount(ctx, countKey, count-uint64(-delta))
+	}
+	return k.setCount(ctx, countKey, count+uint64(delta))
+}
+
 func (k Keeper) importAutoIncrementID(ctx context.Context, sequenceKey []byte, val uint64) error {
 	store := k.storeService.OpenKVStore(ctx)
 	ok, err := store.Has(sequenceKey)
@@ -1404,9 +2032,170 @@ func (k Keeper) importContract(ctx context.Context, contractAddr sdk.AccAddress,
 	if err != nil {
 		return err
 	}
+	err = k.addToContractHeightSecondaryIndex(ctx, historyEntries[0].Updated, contractAddr)
+	if err != nil {
+		return err
+	}
+	if adminAddr := c.AdminAddr(); adminAddr != nil {
+		if err := k.addToContractAdminSecondaryIndex(ctx, adminAddr, contractAddr); err != nil {
+			return err
+		}
+	}
+	if err := k.importContractPortMapping(ctx, contractAddr, c.IBCPortID); err != nil {
+		return err
+	}
+	if err := k.addToCount(ctx, types.KeyContractCount, 1); err != nil {
+		return err
+	}
 	return k.importContractState(ctx, contractAddr, state)
 }
 
+// importContractPortMapping restores the port to contract mapping for a contract with a rebound port id
+func (k Keeper) importContractPortMapping(ctx context.Context, contractAddr sdk.AccAddress, portID string) error {
+	if portID == "" {
+		return nil
+	}
+	if other, ok := k.ContractByPortID(ctx, portID); ok && !other.Equals(contractAddr) {
+		return errorsmod.Wrapf(types.ErrDuplicate, "port id %s bound to contract %s", portID, other)
+	}
+	return k.addContractPortMapping(ctx, contractAddr, portID)
+}
+
+// ExportContract returns a snapshot of the given contract with its code, contract info, history and full state
+func (k Keeper) ExportContract(ctx context.Context, contractAddr sdk.AccAddress) (types.GenesisContract, error) {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return types.GenesisContract{}, types.ErrNoSuchContractFn(contractAddr.String()).Wrapf("address %s", contractAddr.String())
+	}
+	codeInfo := k.GetCodeInfo(ctx, contractInfo.CodeID)
+	if codeInfo == nil {
+		return types.GenesisContract{}, types.ErrNoSuchCodeFn(contractInfo.CodeID).Wrapf("code id %d", contractInfo.CodeID)
+	}
+	bytecode, err := k.GetByteCode(ctx, contractInfo.CodeID)
+	if err != nil {
+		return types.GenesisContract{}, err
+	}
+	var state []types.Model
+	k.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+		state = append(state, types.Model{Key: key, Value: value})
+		return false
+	})
+	return types.GenesisContract{
+		Code: types.Code{
+			CodeID:        contractInfo.CodeID,
+			CodeInfo:      *codeInfo,
+			CodeBytes:     bytecode,
+			Pinned:        k.IsPinnedCode(ctx, contractInfo.CodeID),
+			GasLimit:      k.GetCodeGasLimit(ctx, contractInfo.CodeID),
+			SudoAllowList: k.GetSudoAllowList(ctx, contractInfo.CodeID),
+			Metadata:      k.GetCodeMetadata(ctx, contractInfo.CodeID),
+		},
+		Contract: types.Contract{
+			ContractAddress:     contractAddr.String(),
+			ContractInfo:        *contractInfo,
+			ContractState:       state,
+			ContractCodeHistory: k.GetContractHistory(ctx, contractAddr),
+			Paused:              k.IsContractPaused(ctx, contractAddr),
+			StateVersion:        k.GetContractStateVersion(ctx, contractAddr),
+		},
+	}, nil
+}
+
+// ImportContract recreates a contract from a snapshot under the given address, which can differ from the
+// exported one. The referenced code must exist already. An existing contract at the address is only replaced
+// when force is set.
+func (k Keeper) ImportContract(ctx context.Context, contractAddr sdk.AccAddress, snapshot types.GenesisContract, force bool) error {
+	if err := snapshot.Contract.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	contractInfo := snapshot.Contract.ContractInfo
+	if !k.containsCodeInfo(ctx, contractInfo.CodeID) {
+		return types.ErrNoSuchCodeFn(contractInfo.CodeID).Wrapf("code id %d", contractInfo.CodeID)
+	}
+	if snapshot.Contract.ContractAddress != contractAddr.String() {
+		// ports are bound to the contract address and not moved with the snapshot
+		contractInfo.IBCPortID = ""
+		contractInfo.IBC2PortID = ""
+	}
+	if k.HasContractInfo(ctx, contractAddr) {
+		if !force {
+			return errorsmod.Wrapf(types.ErrDuplicate, "contract: %s", contractAddr)
+		}
+		if err := k.removeContract(ctx, contractAddr); err != nil {
+			return errorsmod.Wrap(err, "remove existing contract")
+		}
+	}
+	if err := k.importContract(ctx, contractAddr, &contractInfo, snapshot.Contract.ContractState, snapshot.Contract.ContractCodeHistory); err != nil {
+		return err
+	}
+	return k.importContractFlags(ctx, contractAddr, snapshot.Contract)
+}
+
+// importContractFlags restores the pause flag and the state version of an imported contract
+func (k Keeper) importContractFlags(ctx context.Context, contractAddr sdk.AccAddress, c types.Contract) error {
+	if c.Paused {
+		if err := k.SetContractPaused(ctx, k.authority, contractAddr, true); err != nil {
+			return errorsmod.Wrap(err, "paused")
+		}
+	}
+	if c.StateVersion != 0 {
+		if err := k.SetContractStateVersion(ctx, contractAddr, c.StateVersion); err != nil {
+			return errorsmod.Wrap(err, "state version")
+		}
+	}
+	return nil
+}
+
+// removeContract deletes the contract info, history, secondary indexes and state of a contract
+func (k Keeper) removeContract(ctx context.Context, contractAddr sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return types.ErrNoSuchContractFn(contractAddr.String()).Wrapf("address %s", contractAddr.String())
+	}
+	history := k.GetContractHistory(ctx, contractAddr)
+	if len(history) != 0 {
+		if err := k.removeFromContractCodeSecondaryIndex(ctx, contractAddr, history[len(history)-1]); err != nil {
+			return err
+		}
+		creatorAddress, err := sdk.AccAddressFromBech32(contractInfo.Creator)
+		if err != nil {
+			return err
+		}
+		store := k.storeService.OpenKVStore(ctx)
+		if err := store.Delete(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, history[0].Updated.Bytes(), contractAddr)); err != nil {
+			return err
+		}
+		if err := store.Delete(types.GetContractByHeightSecondaryIndexKey(history[0].Updated.BlockHeight, contractAddr)); err != nil {
+			return err
+		}
+	}
+	if admin := contractInfo.AdminAddr(); admin != nil {
+		if err := k.removeFromContractAdminSecondaryIndex(ctx, admin, contractAddr); err != nil {
+			return err
+		}
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, prefixStoreKey := range [][]byte{types.GetContractCodeHistoryElementPrefix(contractAddr), types.GetContractStorePrefix(contractAddr)} {
+		prefixStore := prefix.NewStore(store, prefixStoreKey)
+		iter := prefixStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			prefixStore.Delete(key)
+		}
+	}
+	store.Delete(types.GetContractAddressKey(contractAddr))
+	store.Delete(types.GetContractPausedKey(contractAddr))
+	store.Delete(types.GetContractStateVersionKey(contractAddr))
+	if err := k.removeContractPortMapping(ctx, contractInfo.IBCPortID); err != nil {
+		return err
+	}
+	return k.addToCount(ctx, types.KeyContractCount, -1)
+}
+
 func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress) QueryHandler {
 	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.gasRegister)
 }
@@ -1550,3 +2339,184 @@ func (h DefaultWasmVMContractResponseHandler) Handle(ctx sdk.Context, contractAd
 	}
 	return result, nil
 }
+
+// SetContractPaused pauses or unpauses the given contract. Execute, sudo and migrate calls to a paused
+// contract are rejected with types.ErrContractPaused while queries remain possible.
+// The caller must be the module authority.
+func (k Keeper) SetContractPaused(ctx context.Context, authority string, contractAddress sdk.AccAddress, paused bool) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	eventType := types.EventTypePauseContract
+	if paused {
+		if err := store.Set(types.GetContractPausedKey(contractAddress), []byte{1}); err != nil {
+			return err
+		}
+	} else {
+		if err := store.Delete(types.GetContractPausedKey(contractAddress)); err != nil {
+			return err
+		}
+		eventType = types.EventTypeUnpauseContract
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
+	return nil
+}
+
+// SetUploadFrozen freezes or unfreezes the upload of new wasm code. While frozen, StoreCode is rejected with
+// types.ErrUploadFrozen. Existing code and contracts are not affected.
+// The caller must be the module authority.
+func (k Keeper) SetUploadFrozen(ctx context.Context, authority string, frozen bool) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if !frozen {
+		return store.Delete(types.UploadFrozenKey)
+	}
+	return store.Set(types.UploadFrozenKey, []byte{1})
+}
+
+// IsUploadFrozen returns true when code uploads were frozen
+func (k Keeper) IsUploadFrozen(ctx context.Context) bool {
+	ok, err := k.storeService.OpenKVStore(ctx).Has(types.UploadFrozenKey)
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// RebindContractPort replaces the IBC port id stored for the given contract. The new port id must carry the
+// keeper port prefix so that packets are routed to this module and must not be derived from or bound to another
+// contract. Ports other than the derived one are stored in a port to contract mapping. The rebind is rejected
+// while any channel on the current port is open.
+func (k Keeper) RebindContractPort(ctx context.Context, authority string, contractAddress sdk.AccAddress, newPortID string) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	if contractInfo.IBCPortID == "" {
+		return errorsmod.Wrap(types.ErrUnsupportedForContract, "no ibc port")
+	}
+	if err := host.PortIdentifierValidator(newPortID); err != nil {
+		return errorsmod.Wrap(types.ErrInvalid, err.Error())
+	}
+	if newPortID == contractInfo.IBCPortID {
+		return errorsmod.Wrapf(types.ErrDuplicate, "port id %s", newPortID)
+	}
+	if !strings.HasPrefix(newPortID, k.portIDPrefix) {
+		return errorsmod.Wrapf(types.ErrInvalid, "port id %s without prefix %s", newPortID, k.portIDPrefix)
+	}
+	if addr, err := contractFromPortID(k.portIDPrefix, newPortID); err == nil && !addr.Equals(contractAddress) {
+		return errorsmod.Wrapf(types.ErrInvalid, "port id %s is derived from another address", newPortID)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	ok, err := store.Has(types.GetContractByPortIDKey(newPortID))
+	if err != nil {
+		return err
+	}
+	if ok {
+		return errorsmod.Wrapf(types.ErrDuplicate, "port id %s bound to another contract", newPortID)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, ch := range k.channelKeeper.GetAllChannelsWithPortPrefix(sdkCtx, contractInfo.IBCPortID) {
+		if ch.PortId == contractInfo.IBCPortID && ch.State == channeltypes.OPEN {
+			return errorsmod.Wrapf(types.ErrInvalid, "open channel %s on port %s", ch.ChannelId, ch.PortId)
+		}
+	}
+	if err := k.removeContractPortMapping(ctx, contractInfo.IBCPortID); err != nil {
+		return err
+	}
+	if err := k.addContractPortMapping(ctx, contractAddress, newPortID); err != nil {
+		return err
+	}
+	contractInfo.IBCPortID = newPortID
+	k.mustStoreContractInfo(ctx, contractAddress, contractInfo)
+	return nil
+}
+
+// addContractPortMapping stores the contract for a port id that is not derived from the contract address.
+// Derived port ids are resolved without a store entry.
+func (k Keeper) addContractPortMapping(ctx context.Context, contractAddress sdk.AccAddress, portID string) error {
+	if portID == "" || portID == k.PortIDForContract(contractAddress) {
+		return nil
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.GetContractByPortIDKey(portID), contractAddress)
+}
+
+// removeContractPortMapping deletes the stored contract for the given port id, if any
+func (k Keeper) removeContractPortMapping(ctx context.Context, portID string) error {
+	if portID == "" {
+		return nil
+	}
+	return k.storeService.OpenKVStore(ctx).Delete(types.GetContractByPortIDKey(portID))
+}
+
+// ContractByPortID returns the contract that owns the given IBC port. Ports assigned with RebindContractPort are
+// read from the port to contract mapping, all other contract addresses are derived from the port id. The
+// contract must have the port bound. False is returned for all other ports.
+func (k Keeper) ContractByPortID(ctx context.Context, portID string) (sdk.AccAddress, bool) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractByPortIDKey(portID))
+	if err != nil {
+		panic(err)
+	}
+	contractAddr := sdk.AccAddress(bz)
+	if bz == nil {
+		if contractAddr, err = contractFromPortID(k.portIDPrefix, portID); err != nil {
+			return nil, false
+		}
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil || contractInfo.IBCPortID != portID {
+		return nil, false
+	}
+	return contractAddr, true
+}
+
+// IsContractPaused returns true when the given contract was paused
+func (k Keeper) IsContractPaused(ctx context.Context, contractAddress sdk.AccAddress) bool {
+	ok, err := k.storeService.OpenKVStore(ctx).Has(types.GetContractPausedKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// checkContractNotPaused returns an error when the given contract was paused
+func (k Keeper) checkContractNotPaused(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	// the lookup is not charged so that contracts that are not paused keep their gas costs
+	if k.IsContractPaused(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), contractAddress) {
+		return errorsmod.Wrapf(types.ErrContractPaused, "address %s", contractAddress)
+	}
+	return nil
+}
+
+// SetContractStateVersion stores the state schema version of the given contract. The version is kept outside
+// of the contract store so that it can not collide with contract keys. Migrations can use it to branch.
+func (k Keeper) SetContractStateVersion(ctx context.Context, contractAddress sdk.AccAddress, version uint64) error {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.GetContractStateVersionKey(contractAddress), sdk.Uint64ToBigEndian(version))
+}
+
+// GetContractStateVersion returns the state schema version of the given contract or 0 when not set
+func (k Keeper) GetContractStateVersion(ctx context.Context, contractAddress sdk.AccAddress) uint64 {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractStateVersionKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
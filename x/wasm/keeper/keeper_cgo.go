@@ -46,19 +46,24 @@ func NewKeeper(
 		wasmVM:               nil,
 		accountKeeper:        accountKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
+		bankView:             bankKeeper,
+		channelKeeper:        channelKeeper,
+		stakingKeeper:        stakingKeeper,
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
 		queryGasLimit:        nodeConfig.SmartQueryGasLimit,
 		gasRegister:          types.NewDefaultWasmGasRegister(),
 		maxQueryStackSize:    types.DefaultMaxQueryStackSize,
 		maxCallDepth:         types.DefaultMaxCallDepth,
+		maxContractMessages:  types.DefaultMaxContractMessages,
 		acceptedAccountTypes: defaultAcceptedAccountTypes,
 		params:               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		propagateGovAuthorization: map[types.AuthorizationPolicyAction]struct{}{
 			types.AuthZActionInstantiate: {},
 		},
-		authority:   authority,
-		wasmLimits:  vmConfig.WasmLimits,
-		ibcRouterV2: ibcRouterV2,
+		authority:    authority,
+		wasmLimits:   vmConfig.WasmLimits,
+		ibcRouterV2:  ibcRouterV2,
+		portIDPrefix: portIDPrefix,
 	}
 	keeper.messenger = NewDefaultMessageHandler(keeper, router, ics4Wrapper, bankKeeper, cdc, portSource)
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distrKeeper, channelKeeper, keeper)
@@ -67,7 +72,12 @@ func NewKeeper(
 		o.apply(keeper)
 	}
 	// always wrap the messenger, even if it was replaced by an option
-	keeper.messenger = callDepthMessageHandler{keeper.messenger, keeper.maxCallDepth}
+	keeper.messenger = callDepthMessageHandler{
+		Messenger:        keeper.messenger,
+		MaxCallDepth:     keeper.maxCallDepth,
+		DepthGasBase:     keeper.callDepthGasBase,
+		DepthGasPerLevel: keeper.callDepthGasPerLevel,
+	}
 	// only set the wasmvm if no one set this in the options
 	// NewVM does a lot, so better not to create it and silently drop it.
 	if keeper.wasmVM == nil {
@@ -90,6 +100,13 @@ func NewKeeper(
 		o.apply(keeper)
 	}
 	// not updatable, yet
-	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(keeper.messenger, keeper))
+	dispatcher := NewMessageDispatcher(keeper.messenger, keeper)
+	if keeper.replyEventsFilter != nil {
+		dispatcher.replyEvents = keeper.replyEventsFilter
+	}
+	dispatcher.transferReplyData = keeper.ibcTransferReplyData
+	dispatcher.undelegateReplyData = keeper.undelegateReplyData
+	dispatcher.maxMessages = keeper.maxContractMessages
+	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(dispatcher)
 	return *keeper
 }
@@ -2,12 +2,16 @@ package keeper
 
 import (
 	"bytes"
+	"context"
 	_ "embed"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	stdrand "math/rand"
 	"os"
+	"slices"
+	"strconv"
 	"testing"
 	"time"
 
@@ -18,6 +22,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -332,6 +337,290 @@ func TestCreateDuplicate(t *testing.T) {
 	require.Equal(t, hackatomWasm, storedCode)
 }
 
+func TestCreateDuplicateWithDeduplication(t *testing.T) {
+	onlyCreator := func(addr sdk.AccAddress) *types.AccessConfig {
+		c := types.AccessTypeAnyOfAddresses.With(addr)
+		return &c
+	}
+	specs := map[string]struct {
+		dedup          bool
+		instantiateCfg func(creator sdk.AccAddress) *types.AccessConfig
+		expDuplicateID uint64
+	}{
+		"dedup off": {
+			expDuplicateID: 2,
+		},
+		"dedup on": {
+			dedup:          true,
+			expDuplicateID: 1,
+		},
+		"dedup on - different instantiate config": {
+			dedup:          true,
+			instantiateCfg: onlyCreator,
+			expDuplicateID: 2,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			params := types.DefaultParams()
+			params.CodeDeduplication = spec.dedup
+			require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+			creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+			otherCreator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+			contractID, checksum, err := keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), contractID)
+			var instantiateCfg *types.AccessConfig
+			if spec.instantiateCfg != nil {
+				instantiateCfg = spec.instantiateCfg(otherCreator)
+			}
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// when
+			duplicateID, gotChecksum, err := keepers.ContractKeeper.Create(ctx, otherCreator, hackatomWasm, instantiateCfg)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, spec.expDuplicateID, duplicateID)
+			assert.Equal(t, checksum, gotChecksum)
+			// a deduplicated code keeps its original creator
+			expCreator := otherCreator
+			if duplicateID == contractID {
+				expCreator = creator
+			}
+			assert.Equal(t, expCreator.String(), keepers.WasmKeeper.GetCodeInfo(ctx, duplicateID).Creator)
+			var codeIDs []uint64
+			keepers.WasmKeeper.IterateCodeInfos(ctx, func(id uint64, _ types.CodeInfo) bool {
+				codeIDs = append(codeIDs, id)
+				return false
+			})
+			assert.Len(t, codeIDs, int(spec.expDuplicateID))
+			storedCode, err := keepers.WasmKeeper.GetByteCode(ctx, duplicateID)
+			require.NoError(t, err)
+			assert.Equal(t, hackatomWasm, storedCode)
+			// store code event with the returned code id
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			attr, ok := events[0].GetAttribute(types.AttributeKeyCodeID)
+			require.True(t, ok)
+			assert.Equal(t, strconv.FormatUint(duplicateID, 10), attr.Value)
+		})
+	}
+}
+
+func TestExecuteWithGasMeter(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	releaseMsg := []byte(`{"release":{}}`)
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	verifierBalance := keepers.BankKeeper.GetAllBalances(parentCtx, example.VerifierAddr)
+
+	// gas used by a regular execution
+	refCtx, _ := parentCtx.CacheContext()
+	refCtx = refCtx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+	_, err := keepers.ContractKeeper.Execute(refCtx, example.Contract, example.VerifierAddr, releaseMsg, funds)
+	require.NoError(t, err)
+	expGas := refCtx.GasMeter().GasConsumed()
+
+	specs := map[string]struct {
+		meter  storetypes.GasMeter
+		expGas uint64
+		expErr *errorsmod.Error
+	}{
+		"enough gas": {
+			meter:  storetypes.NewGasMeter(10_000_000),
+			expGas: expGas,
+		},
+		"infinite gas": {
+			meter:  storetypes.NewInfiniteGasMeter(),
+			expGas: expGas,
+		},
+		"out of gas": {
+			meter:  storetypes.NewGasMeter(expGas / 2),
+			expGas: expGas / 2,
+			expErr: sdkerrors.ErrOutOfGas,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctxMeter := storetypes.NewGasMeter(1_000)
+			em := sdk.NewEventManager()
+			ctx = ctx.WithGasMeter(ctxMeter).WithEventManager(em)
+			// when
+			_, gotErr := k.ExecuteWithGasMeter(ctx, example.Contract, example.VerifierAddr, releaseMsg, funds, spec.meter)
+			// then
+			assert.Equal(t, spec.expGas, spec.meter.GasConsumedToLimit())
+			assert.Equal(t, storetypes.Gas(0), ctxMeter.GasConsumed())
+			assert.Same(t, ctxMeter, ctx.GasMeter())
+			gotBalance := keepers.BankKeeper.GetAllBalances(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), example.VerifierAddr)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				// no state changes or events are committed
+				assert.Equal(t, verifierBalance, gotBalance)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, verifierBalance.Sub(funds...), gotBalance)
+			assert.NotEmpty(t, em.Events())
+		})
+	}
+}
+
+func TestCodesByCreator(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.ContractKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator1 := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	creator2 := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+
+	var exp1, exp2 []uint64
+	for _, creator := range []sdk.AccAddress{creator1, creator2, creator2, creator1, creator1} {
+		codeID, _, err := keeper.Create(ctx, creator, hackatomWasm, nil)
+		require.NoError(t, err)
+		if creator.Equals(creator1) {
+			exp1 = append(exp1, codeID)
+		} else {
+			exp2 = append(exp2, codeID)
+		}
+	}
+
+	// when
+	got1, err := keepers.WasmKeeper.CodesByCreator(ctx, creator1)
+	require.NoError(t, err)
+	got2, err := keepers.WasmKeeper.CodesByCreator(ctx, creator2)
+	require.NoError(t, err)
+	gotOther, err := keepers.WasmKeeper.CodesByCreator(ctx, RandomAccountAddress(t))
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []uint64{1, 4, 5}, exp1)
+	assert.Equal(t, exp1, got1)
+	assert.Equal(t, exp2, got2)
+	assert.Empty(t, gotOther)
+	_, err = keepers.WasmKeeper.CodesByCreator(ctx, nil)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
+
+func TestContractsByAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := StoreRandomContract(t, ctx, keepers, &mock)
+	admin1, admin2 := RandomAccountAddress(t), RandomAccountAddress(t)
+
+	instantiate := func(admin sdk.AccAddress) sdk.AccAddress {
+		addr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, admin, []byte("{}"), "label", nil)
+		require.NoError(t, err)
+		return addr
+	}
+	assertContracts := func(t *testing.T, admin sdk.AccAddress, exp ...sdk.AccAddress) {
+		t.Helper()
+		slices.SortFunc(exp, func(a, b sdk.AccAddress) int { return bytes.Compare(a, b) })
+		got, err := k.ContractsByAdmin(ctx, admin)
+		require.NoError(t, err)
+		if len(exp) == 0 {
+			assert.Empty(t, got)
+			return
+		}
+		assert.Equal(t, exp, got)
+	}
+
+	// on instantiate
+	contract1 := instantiate(admin1)
+	contract2 := instantiate(admin1)
+	contract3 := instantiate(admin2)
+	noAdminContract := instantiate(nil)
+	assertContracts(t, admin1, contract1, contract2)
+	assertContracts(t, admin2, contract3)
+	assertContracts(t, example.CreatorAddr)
+
+	// on admin change
+	require.NoError(t, keepers.ContractKeeper.UpdateContractAdmin(ctx, contract1, admin1, admin2))
+	assertContracts(t, admin1, contract2)
+	assertContracts(t, admin2, contract1, contract3)
+
+	// on clear admin
+	require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, contract2, admin1))
+	assertContracts(t, admin1)
+	assertContracts(t, admin2, contract1, contract3)
+
+	// and set admin to a contract without admin
+	require.NoError(t, k.setContractAdmin(ctx, noAdminContract, nil, admin1, GovAuthorizationPolicy{}))
+	assertContracts(t, admin1, noAdminContract)
+
+	_, err := k.ContractsByAdmin(ctx, nil)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
+
+func TestContractsInstantiatedBetween(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := StoreRandomContract(t, parentCtx, keepers, &mock)
+
+	instantiate := func(height int64) sdk.AccAddress {
+		addr, _, err := keepers.ContractKeeper.Instantiate(parentCtx.WithBlockHeight(height), example.CodeID, example.CreatorAddr, nil, []byte("{}"), "label", nil)
+		require.NoError(t, err)
+		return addr
+	}
+	sorted := func(addrs ...sdk.AccAddress) []sdk.AccAddress {
+		slices.SortFunc(addrs, func(a, b sdk.AccAddress) int { return bytes.Compare(a, b) })
+		return addrs
+	}
+	contractsAt10 := sorted(instantiate(10), instantiate(10))
+	contractAt11 := instantiate(11)
+	contractAt13 := instantiate(13)
+
+	specs := map[string]struct {
+		from, to uint64
+		exp      []sdk.AccAddress
+		expErr   error
+	}{
+		"all": {
+			from: 0, to: math.MaxUint64,
+			exp: append(slices.Clone(contractsAt10), contractAt11, contractAt13),
+		},
+		"single height": {
+			from: 10, to: 10,
+			exp: contractsAt10,
+		},
+		"bounds included": {
+			from: 11, to: 13,
+			exp: []sdk.AccAddress{contractAt11, contractAt13},
+		},
+		"sub range": {
+			from: 11, to: 12,
+			exp: []sdk.AccAddress{contractAt11},
+		},
+		"empty range": {
+			from: 14, to: 20,
+			exp: []sdk.AccAddress{},
+		},
+		"from after to": {
+			from: 13, to: 10,
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := k.ContractsInstantiatedBetween(parentCtx, spec.from, spec.to)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func TestCreateWithSimulation(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
@@ -423,7 +712,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x1bcb7), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1d747), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -1087,6 +1376,83 @@ func TestExecuteWithNonExistingAddress(t *testing.T) {
 	require.Equal(t, types.ErrNoSuchContractFn(nonExistingAddress.String()).Wrapf("address %s", nonExistingAddress.String()).Error(), err.Error())
 }
 
+func TestPreExecuteHooks(t *testing.T) {
+	type hookCall struct {
+		hook           string
+		contract, from sdk.AccAddress
+		msg            []byte
+		funds          sdk.Coins
+	}
+	var (
+		calls     []hookCall
+		vetoErr   error
+		newHookFn = func(name string, veto bool) PreExecuteHook {
+			return PreExecuteHookFn(func(ctx context.Context, contractAddr, caller sdk.AccAddress, msg []byte, funds sdk.Coins) error {
+				calls = append(calls, hookCall{hook: name, contract: contractAddr, from: caller, msg: msg, funds: funds})
+				if veto {
+					return vetoErr
+				}
+				return nil
+			})
+		}
+	)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities,
+		WithPreExecuteHooks(newHookFn("first", false), newHookFn("second", true)))
+	var vmCalled bool
+	mock := wasmtesting.MockWasmEngine{
+		ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+			vmCalled = true
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	caller := RandomAccountAddress(t)
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	keepers.Faucet.Fund(parentCtx, caller, funds...)
+	msg := []byte(`{"foo":{}}`)
+
+	specs := map[string]struct {
+		vetoErr  error
+		expCalls []string
+		expErr   error
+	}{
+		"all hooks pass": {
+			expCalls: []string{"first", "second"},
+		},
+		"second hook vetoes": {
+			vetoErr:  sdkerrors.ErrUnauthorized,
+			expCalls: []string{"first", "second"},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			calls, vetoErr, vmCalled = nil, spec.vetoErr, false
+			// when
+			_, gotErr := keepers.ContractKeeper.Execute(ctx, example.Contract, caller, msg, funds)
+			// then
+			require.Len(t, calls, len(spec.expCalls))
+			for i, c := range calls {
+				assert.Equal(t, spec.expCalls[i], c.hook)
+				assert.Equal(t, example.Contract, c.contract)
+				assert.Equal(t, caller, c.from)
+				assert.Equal(t, msg, c.msg)
+				assert.Equal(t, funds, c.funds)
+			}
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.False(t, vmCalled)
+				assert.Equal(t, funds, keepers.BankKeeper.GetAllBalances(ctx, caller))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, vmCalled)
+		})
+	}
+}
+
 func TestExecuteWithPanic(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.ContractKeeper
@@ -1489,6 +1855,70 @@ func TestMigrateReplacesTheSecondIndex(t *testing.T) {
 	require.False(t, exists)
 }
 
+func TestMigrationHooks(t *testing.T) {
+	type hookCall struct {
+		hook             string
+		contract         sdk.AccAddress
+		fromCode, toCode uint64
+	}
+	specs := map[string]struct {
+		hookErr     error
+		abortOnErr  bool
+		expErr      error
+		expHookCall []string
+	}{
+		"all hooks pass": {
+			expHookCall: []string{"first", "second"},
+		},
+		"hook error logged only": {
+			hookErr:     types.ErrInvalid,
+			expHookCall: []string{"first", "second"},
+		},
+		"hook error aborts": {
+			hookErr:     types.ErrInvalid,
+			abortOnErr:  true,
+			expErr:      types.ErrInvalid,
+			expHookCall: []string{"first"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var calls []hookCall
+			newHookFn := func(name string) MigrationHook {
+				return MigrationHookFn(func(ctx context.Context, contractAddr sdk.AccAddress, fromCodeID, toCodeID uint64) error {
+					calls = append(calls, hookCall{hook: name, contract: contractAddr, fromCode: fromCodeID, toCode: toCodeID})
+					return spec.hookErr
+				})
+			}
+			opts := []Option{WithMigrationHooks(newHookFn("first"), newHookFn("second"))}
+			if spec.abortOnErr {
+				opts = append(opts, WithMigrationHookErrorsAbort())
+			}
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, opts...)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+			migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+
+			// when
+			_, gotErr := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeExample.CodeID, migMsgBz)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			require.Len(t, calls, len(spec.expHookCall))
+			for i, c := range calls {
+				assert.Equal(t, spec.expHookCall[i], c.hook)
+				assert.Equal(t, example.Contract, c.contract)
+				assert.Equal(t, example.CodeID, c.fromCode)
+				assert.Equal(t, newCodeExample.CodeID, c.toCode)
+			}
+		})
+	}
+}
+
 func TestMigrateWithDispatchedMessage(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.ContractKeeper
@@ -1665,6 +2095,60 @@ type stealFundsMsg struct {
 	Amount    wasmvmtypes.Array[wasmvmtypes.Coin] `json:"amount"`
 }
 
+func TestCodeGasLimit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	const contractWork = 1_000 // in sdk gas
+	workInVMGas := k.gasRegister.ToWasmVMGas(contractWork)
+	mock := wasmtesting.MockWasmEngine{
+		ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+			if gasLimit < workInVMGas {
+				return nil, gasLimit, errors.New("out of gas")
+			}
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, workInVMGas, nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+
+	specs := map[string]struct {
+		limit  uint64
+		expErr *errorsmod.Error
+	}{
+		"no ceiling": {},
+		"under ceiling": {
+			limit: 2 * contractWork,
+		},
+		"over ceiling": {
+			limit:  contractWork / 2,
+			expErr: types.ErrCodeGasLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+			require.NoError(t, k.SetCodeGasLimit(ctx, k.GetAuthority(), example.CodeID, spec.limit))
+			assert.Equal(t, spec.limit, k.GetCodeGasLimit(ctx, example.CodeID))
+
+			// when
+			_, gotErr := keepers.ContractKeeper.Execute(ctx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+	// and unknown code
+	assert.ErrorIs(t, k.SetCodeGasLimit(parentCtx, k.GetAuthority(), 999, 1), types.ErrNoSuchCodeFn(999))
+	// and invalid authority
+	assert.ErrorIs(t, k.SetCodeGasLimit(parentCtx, RandomBech32AccountAddress(t), example.CodeID, 1), types.ErrInvalid)
+	assert.Zero(t, k.GetCodeGasLimit(parentCtx, example.CodeID))
+}
+
 func TestSudo(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.ContractKeeper, keepers.BankKeeper
@@ -1724,54 +2208,311 @@ func TestSudo(t *testing.T) {
 	assert.Equal(t, expEvt, em.Events()[0])
 }
 
-func prettyEvents(t *testing.T, events sdk.Events) string {
-	t.Helper()
-	type prettyEvent struct {
-		Type string
-		Attr []map[string]string
-	}
+func TestContractByPortID(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	ibcExample := InstantiateIBCReflectContract(t, parentCtx, keepers)
+	nonIBCExample := InstantiateReflectExampleContract(t, parentCtx, keepers)
+	require.Empty(t, k.GetContractInfo(parentCtx, nonIBCExample.Contract).IBCPortID)
+	reboundExample := InstantiateIBCReflectContract(t, parentCtx, keepers)
+	require.NoError(t, k.RebindContractPort(parentCtx, k.GetAuthority(), reboundExample.Contract, "wasm.rebound"))
 
-	r := make([]prettyEvent, len(events))
-	for i, e := range events {
-		attr := make([]map[string]string, len(e.Attributes))
-		for j, a := range e.Attributes {
-			attr[j] = map[string]string{a.Key: a.Value}
-		}
-		r[i] = prettyEvent{Type: e.Type, Attr: attr}
+	specs := map[string]struct {
+		portID  string
+		expAddr sdk.AccAddress
+		expOK   bool
+	}{
+		"derived port": {
+			portID:  PortIDForContract(ibcExample.Contract),
+			expAddr: ibcExample.Contract,
+			expOK:   true,
+		},
+		"rebound port": {
+			portID:  "wasm.rebound",
+			expAddr: reboundExample.Contract,
+			expOK:   true,
+		},
+		"derived port of rebound contract": {
+			portID: PortIDForContract(reboundExample.Contract),
+		},
+		"derived port of contract without ibc": {
+			portID: PortIDForContract(nonIBCExample.Contract),
+		},
+		"derived port of unknown contract": {
+			portID: PortIDForContract(RandomAccountAddress(t)),
+		},
+		"port without wasm prefix": {
+			portID: "transfer",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotAddr, gotOK := k.ContractByPortID(parentCtx, spec.portID)
+			assert.Equal(t, spec.expOK, gotOK)
+			assert.Equal(t, spec.expAddr, gotAddr)
+		})
 	}
-	return string(mustMarshal(t, r))
-}
-
-func mustMarshal(t *testing.T, r interface{}) []byte {
-	t.Helper()
-	bz, err := json.Marshal(r)
-	require.NoError(t, err)
-	return bz
 }
 
-func TestUpdateContractAdmin(t *testing.T) {
+func TestSudoDryRun(t *testing.T) {
 	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
-	keeper := keepers.ContractKeeper
+	accKeeper, bankKeeper := keepers.AccountKeeper, keepers.BankKeeper
 
 	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
-	topUp := sdk.NewCoins(sdk.NewInt64Coin("denom", 5000))
 	creator := DeterministicAccountAddress(t, 1)
 	keepers.Faucet.Fund(parentCtx, creator, deposit.Add(deposit...)...)
-	fred := keepers.Faucet.NewFundedRandomAccount(parentCtx, topUp...)
 
-	originalContractID, _, err := keeper.Create(parentCtx, creator, hackatomWasm, nil)
+	contractID, _, err := keepers.ContractKeeper.Create(parentCtx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+	_, bob := keyPubAddr()
+	_, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+	addr, _, err := keepers.ContractKeeper.Instantiate(parentCtx, contractID, creator, nil, initMsgBz, "demo contract", deposit)
 	require.NoError(t, err)
 
-	_, anyAddr := keyPubAddr()
-	initMsg := HackatomExampleInitMsg{
-		Verifier:    fred,
-		Beneficiary: anyAddr,
-	}
-	initMsgBz, err := json.Marshal(initMsg)
+	_, community := keyPubAddr()
+	sudoMsg, err := json.Marshal(sudoMsg{
+		StealFunds: stealFundsMsg{
+			Recipient: community.String(),
+			Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(76543, "denom")},
+		},
+	})
 	require.NoError(t, err)
-	specs := map[string]struct {
-		instAdmin            sdk.AccAddress
-		newAdmin             sdk.AccAddress
+
+	// when
+	em := sdk.NewEventManager()
+	ctx := parentCtx.WithEventManager(em)
+	gotData, gotEvents, gotErr := keepers.WasmKeeper.SudoDryRun(ctx, addr, sudoMsg)
+
+	// then
+	require.NoError(t, gotErr)
+	assert.Empty(t, em.Events())
+	assert.Nil(t, accKeeper.GetAccount(ctx, community))
+	assert.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, addr))
+
+	// and matches the result of an actual sudo
+	expData, err := keepers.WasmKeeper.Sudo(ctx, addr, sudoMsg)
+	require.NoError(t, err)
+	assert.Equal(t, expData, gotData)
+	assert.Equal(t, em.Events(), gotEvents)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 76543), bankKeeper.GetBalance(ctx, community, "denom"))
+
+	// and
+	_, _, gotErr = keepers.WasmKeeper.SudoDryRun(ctx, RandomAccountAddress(t), sudoMsg)
+	assert.ErrorIs(t, gotErr, types.ErrNoSuchContractFn(""))
+}
+
+func TestSudoMulti(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	bankKeeper := keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := DeterministicAccountAddress(t, 1)
+	keepers.Faucet.Fund(parentCtx, creator, deposit.Add(deposit...)...)
+
+	contractID, _, err := keepers.ContractKeeper.Create(parentCtx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+	_, bob := keyPubAddr()
+	_, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+	addr1, _, err := keepers.ContractKeeper.Instantiate(parentCtx, contractID, creator, nil, initMsgBz, "first", deposit)
+	require.NoError(t, err)
+	addr2, _, err := keepers.ContractKeeper.Instantiate(parentCtx, contractID, creator, nil, initMsgBz, "second", deposit)
+	require.NoError(t, err)
+
+	_, community := keyPubAddr()
+	sudoMsg, err := json.Marshal(sudoMsg{
+		StealFunds: stealFundsMsg{
+			Recipient: community.String(),
+			Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1000, "denom")},
+		},
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		calls          []SudoCall
+		expErr         error
+		expCommunity   int64
+		expSudoEvents  int
+		expBalanceAddr sdk.Coins
+	}{
+		"all succeed": {
+			calls:          []SudoCall{{Contract: addr1, Msg: sudoMsg}, {Contract: addr2, Msg: sudoMsg}},
+			expCommunity:   2000,
+			expSudoEvents:  2,
+			expBalanceAddr: sdk.NewCoins(sdk.NewInt64Coin("denom", 99000)),
+		},
+		"fails partway - all rolled back": {
+			calls:          []SudoCall{{Contract: addr1, Msg: sudoMsg}, {Contract: RandomAccountAddress(t), Msg: sudoMsg}, {Contract: addr2, Msg: sudoMsg}},
+			expErr:         types.ErrNoSuchContractFn(""),
+			expBalanceAddr: deposit,
+		},
+		"invalid msg - all rolled back": {
+			calls:          []SudoCall{{Contract: addr1, Msg: sudoMsg}, {Contract: addr2, Msg: []byte(`{}`)}},
+			expErr:         types.ErrExecuteFailed,
+			expBalanceAddr: deposit,
+		},
+		"empty": {
+			expErr:         types.ErrEmpty,
+			expBalanceAddr: deposit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := keepers.WasmKeeper.SudoMulti(ctx, spec.calls)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+			} else {
+				require.NoError(t, gotErr)
+			}
+			var sudoEvents int
+			for _, e := range em.Events() {
+				if e.Type == types.EventTypeSudo {
+					sudoEvents++
+				}
+			}
+			assert.Equal(t, spec.expSudoEvents, sudoEvents)
+			assert.Equal(t, spec.expCommunity, bankKeeper.GetBalance(ctx, community, "denom").Amount.Int64())
+			assert.Equal(t, spec.expBalanceAddr, bankKeeper.GetAllBalances(ctx, addr1))
+			assert.Equal(t, spec.expBalanceAddr, bankKeeper.GetAllBalances(ctx, addr2))
+		})
+	}
+}
+
+func TestSudoAllowList(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var vmCalled bool
+	mock := wasmtesting.MockWasmEngine{
+		SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+			vmCalled = true
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+
+	specs := map[string]struct {
+		allowList []string
+		msg       string
+		expErr    *errorsmod.Error
+	}{
+		"no allow list - all permitted": {
+			msg: `{"any":{}}`,
+		},
+		"allowed key": {
+			allowList: []string{"foo", "bar"},
+			msg:       `{"bar":{"x":1}}`,
+		},
+		"disallowed key": {
+			allowList: []string{"foo", "bar"},
+			msg:       `{"baz":{}}`,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"multiple top level keys": {
+			allowList: []string{"foo", "bar"},
+			msg:       `{"foo":{},"bar":{}}`,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"not an object": {
+			allowList: []string{"foo"},
+			msg:       `"foo"`,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			vmCalled = false
+			require.NoError(t, k.SetSudoAllowList(ctx, k.GetAuthority(), example.CodeID, spec.allowList))
+
+			// when
+			_, gotErr := k.Sudo(ctx, example.Contract, []byte(spec.msg))
+
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				assert.False(t, vmCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, vmCalled)
+		})
+	}
+	t.Run("replace and reset", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		require.NoError(t, k.SetSudoAllowList(ctx, k.GetAuthority(), example.CodeID, []string{"foo", "bar"}))
+		assert.Equal(t, []string{"bar", "foo"}, k.GetSudoAllowList(ctx, example.CodeID))
+		require.NoError(t, k.SetSudoAllowList(ctx, k.GetAuthority(), example.CodeID, []string{"baz"}))
+		assert.Equal(t, []string{"baz"}, k.GetSudoAllowList(ctx, example.CodeID))
+		require.NoError(t, k.SetSudoAllowList(ctx, k.GetAuthority(), example.CodeID, nil))
+		assert.Empty(t, k.GetSudoAllowList(ctx, example.CodeID))
+	})
+	// and unknown code
+	assert.ErrorIs(t, k.SetSudoAllowList(parentCtx, k.GetAuthority(), 999, []string{"foo"}), types.ErrNoSuchCodeFn(999))
+	// and invalid authority
+	assert.ErrorIs(t, k.SetSudoAllowList(parentCtx, RandomBech32AccountAddress(t), example.CodeID, []string{"foo"}), types.ErrInvalid)
+	assert.Empty(t, k.GetSudoAllowList(parentCtx, example.CodeID))
+}
+
+func prettyEvents(t *testing.T, events sdk.Events) string {
+	t.Helper()
+	type prettyEvent struct {
+		Type string
+		Attr []map[string]string
+	}
+
+	r := make([]prettyEvent, len(events))
+	for i, e := range events {
+		attr := make([]map[string]string, len(e.Attributes))
+		for j, a := range e.Attributes {
+			attr[j] = map[string]string{a.Key: a.Value}
+		}
+		r[i] = prettyEvent{Type: e.Type, Attr: attr}
+	}
+	return string(mustMarshal(t, r))
+}
+
+func mustMarshal(t *testing.T, r interface{}) []byte {
+	t.Helper()
+	bz, err := json.Marshal(r)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestUpdateContractAdmin(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.ContractKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("denom", 5000))
+	creator := DeterministicAccountAddress(t, 1)
+	keepers.Faucet.Fund(parentCtx, creator, deposit.Add(deposit...)...)
+	fred := keepers.Faucet.NewFundedRandomAccount(parentCtx, topUp...)
+
+	originalContractID, _, err := keeper.Create(parentCtx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+
+	_, anyAddr := keyPubAddr()
+	initMsg := HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: anyAddr,
+	}
+	initMsgBz, err := json.Marshal(initMsg)
+	require.NoError(t, err)
+	specs := map[string]struct {
+		instAdmin            sdk.AccAddress
+		newAdmin             sdk.AccAddress
 		overrideContractAddr sdk.AccAddress
 		caller               sdk.AccAddress
 		expErr               *errorsmod.Error
@@ -2177,6 +2918,55 @@ func TestReply(t *testing.T) {
 	}
 }
 
+func TestReplyGasLimit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	const contractWork = 1_000 // in sdk gas
+	workInVMGas := k.gasRegister.ToWasmVMGas(contractWork)
+	mock := wasmtesting.MockWasmEngine{
+		ReplyFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, reply wasmvmtypes.Reply, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+			if gasLimit < workInVMGas {
+				return nil, gasLimit, errors.New("out of gas")
+			}
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, workInVMGas, nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+
+	specs := map[string]struct {
+		limit  uint64
+		expErr *errorsmod.Error
+	}{
+		"no limit": {},
+		"under limit": {
+			limit: 2 * contractWork,
+		},
+		"over limit": {
+			limit:  contractWork / 2,
+			expErr: types.ErrReplyGasLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+			k.replyGasLimit = spec.limit
+			t.Cleanup(func() { k.replyGasLimit = 0 })
+
+			// when
+			_, gotErr := k.reply(ctx, example.Contract, wasmvmtypes.Reply{})
+
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 type replierExecMsg struct {
 	MsgId                 byte             `json:"msg_id"`
 	SetDataInExecAndReply bool             `json:"set_data_in_exec_and_reply"`
@@ -2497,6 +3287,56 @@ func TestSetAccessConfig(t *testing.T) {
 	}
 }
 
+func TestUpdateCodeInstantiatePermission(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	const codeID = 1
+
+	specs := map[string]struct {
+		caller    sdk.AccAddress
+		newConfig types.AccessConfig
+		expErr    *errorsmod.Error
+	}{
+		"creator": {
+			caller:    creatorAddr,
+			newConfig: types.AccessTypeAnyOfAddresses.With(creatorAddr),
+		},
+		"gov": {
+			caller:    sdk.MustAccAddressFromBech32(k.GetAuthority()),
+			newConfig: types.AllowEverybody,
+		},
+		"unauthorized": {
+			caller:    RandomAccountAddress(t),
+			newConfig: types.AllowEverybody,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"invalid config": {
+			caller:    creatorAddr,
+			newConfig: types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses},
+			expErr:    types.ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			k.mustStoreCodeInfo(ctx, codeID, types.NewCodeInfo(nil, creatorAddr, types.AllowNobody))
+
+			// when
+			gotErr := k.UpdateCodeInstantiatePermission(ctx, spec.caller, codeID, spec.newConfig)
+
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, types.AllowNobody, k.GetCodeInfo(ctx, codeID).InstantiateConfig)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.newConfig, k.GetCodeInfo(ctx, codeID).InstantiateConfig)
+		})
+	}
+}
+
 func TestAppendToContractHistory(t *testing.T) {
 	f := fuzz.New().Funcs(ModelFuzzers...)
 	pCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
@@ -2542,6 +3382,53 @@ func TestAppendToContractHistory(t *testing.T) {
 	}
 }
 
+func TestGetContractHistoryByOperation(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := BuildContractAddressClassic(1, 1)
+
+	genesisEntry := types.ContractCodeHistoryEntry{Operation: types.ContractCodeHistoryOperationTypeGenesis, CodeID: 1, Msg: []byte(`{}`)}
+	initEntry := types.ContractCodeHistoryEntry{Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: 1, Msg: []byte(`{"init":{}}`)}
+	migrateEntry1 := types.ContractCodeHistoryEntry{Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: 2, Msg: []byte(`{"migrate":1}`)}
+	migrateEntry2 := types.ContractCodeHistoryEntry{Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: 3, Msg: []byte(`{"migrate":2}`)}
+	require.NoError(t, k.appendToContractHistory(ctx, contractAddr, genesisEntry, initEntry, migrateEntry1, migrateEntry2))
+
+	specs := map[string]struct {
+		ops        []types.ContractCodeHistoryOperationType
+		expHistory []types.ContractCodeHistoryEntry
+	}{
+		"no filter": {
+			expHistory: []types.ContractCodeHistoryEntry{genesisEntry, initEntry, migrateEntry1, migrateEntry2},
+		},
+		"genesis": {
+			ops:        []types.ContractCodeHistoryOperationType{types.ContractCodeHistoryOperationTypeGenesis},
+			expHistory: []types.ContractCodeHistoryEntry{genesisEntry},
+		},
+		"init": {
+			ops:        []types.ContractCodeHistoryOperationType{types.ContractCodeHistoryOperationTypeInit},
+			expHistory: []types.ContractCodeHistoryEntry{initEntry},
+		},
+		"migrate": {
+			ops:        []types.ContractCodeHistoryOperationType{types.ContractCodeHistoryOperationTypeMigrate},
+			expHistory: []types.ContractCodeHistoryEntry{migrateEntry1, migrateEntry2},
+		},
+		"genesis or init": {
+			ops:        []types.ContractCodeHistoryOperationType{types.ContractCodeHistoryOperationTypeGenesis, types.ContractCodeHistoryOperationTypeInit},
+			expHistory: []types.ContractCodeHistoryEntry{genesisEntry, initEntry},
+		},
+		"unspecified": {
+			ops:        []types.ContractCodeHistoryOperationType{types.ContractCodeHistoryOperationTypeUnspecified},
+			expHistory: []types.ContractCodeHistoryEntry{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotHistory := k.GetContractHistoryByOperation(ctx, contractAddr, spec.ops...)
+			assert.Equal(t, spec.expHistory, gotHistory)
+		})
+	}
+}
+
 func TestCoinBurnerPruneBalances(t *testing.T) {
 	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	amts := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
@@ -2623,6 +3510,31 @@ func TestIteratorAllContract(t *testing.T) {
 	require.Equal(t, allContract, expContracts)
 }
 
+func TestRandomContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	r := stdrand.New(stdrand.NewSource(1))
+
+	// no contracts
+	_, found := RandomContract(ctx, *k, r)
+	require.False(t, found)
+
+	// with contracts
+	mock := wasmtesting.MockWasmEngine{}
+	wasmtesting.MakeInstantiable(&mock)
+	exp := make(map[string]struct{})
+	for i := 0; i < 3; i++ {
+		exp[SeedNewContractInstance(t, ctx, keepers, &mock).Contract.String()] = struct{}{}
+	}
+	got := make(map[string]struct{})
+	for i := 0; i < 100; i++ {
+		addr, found := RandomContract(ctx, *k, r)
+		require.True(t, found)
+		got[addr.String()] = struct{}{}
+	}
+	assert.Equal(t, exp, got)
+}
+
 func TestIteratorContractByCreator(t *testing.T) {
 	// setup test
 	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
@@ -2880,6 +3792,662 @@ func TestSetContractLabel(t *testing.T) {
 	}
 }
 
+func TestIterateContractState(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+	models := []types.Model{{Key: []byte("a"), Value: []byte("1")}, {Key: []byte("b"), Value: []byte("2")}, {Key: []byte("c"), Value: []byte("3")}}
+	require.NoError(t, k.importContractState(parentCtx, contractAddr, models))
+
+	specs := map[string]struct {
+		abortAt string
+		expKeys []string
+	}{
+		"full walk": {
+			expKeys: []string{"a", "b", "c"},
+		},
+		"abort early": {
+			abortAt: "b",
+			expKeys: []string{"a", "b"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotKeys []string
+			k.IterateContractState(parentCtx, contractAddr, func(key, value []byte) bool {
+				gotKeys = append(gotKeys, string(key))
+				return string(key) == spec.abortAt
+			})
+			assert.Equal(t, spec.expKeys, gotKeys)
+		})
+	}
+}
+
+func TestIterationOrderIsDeterministic(t *testing.T) {
+	const numCodes = 4
+	addrs := []sdk.AccAddress{DeterministicAccountAddress(t, 3), DeterministicAccountAddress(t, 1), DeterministicAccountAddress(t, 2)}
+	// contracts 0 and 1 share the same created position so that the address decides
+	positions := []types.AbsoluteTxPosition{{BlockHeight: 2, TxIndex: 1}, {BlockHeight: 2, TxIndex: 1}, {BlockHeight: 1, TxIndex: 5}}
+	models := []types.Model{{Key: []byte("b"), Value: []byte("2")}, {Key: []byte("a"), Value: []byte("1")}, {Key: []byte("ab"), Value: []byte("3")}, {Key: []byte{0x0}, Value: []byte("4")}}
+
+	type result struct {
+		pinned    []uint64
+		byCode    []sdk.AccAddress
+		stateKeys [][]byte
+	}
+	run := func(t *testing.T, order []int) result {
+		ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+		k := keepers.WasmKeeper
+		mock := wasmtesting.MockWasmEngine{PinFn: func(checksum wasmvm.Checksum) error { return nil }}
+		wasmtesting.MakeInstantiable(&mock)
+		codeIDs := make([]uint64, numCodes)
+		for i := range codeIDs {
+			codeIDs[i] = StoreRandomContract(t, ctx, keepers, &mock).CodeID
+		}
+		for _, i := range order {
+			if i < numCodes {
+				require.NoError(t, k.pinCode(ctx, codeIDs[i]))
+			}
+			if i < len(addrs) {
+				pos := positions[i]
+				info := types.ContractInfoFixture(func(info *types.ContractInfo) {
+					info.CodeID = codeIDs[0]
+					info.Created = &pos
+				})
+				history := []types.ContractCodeHistoryEntry{types.ContractCodeHistoryEntryFixture(func(e *types.ContractCodeHistoryEntry) {
+					e.CodeID = codeIDs[0]
+					e.Updated = &pos
+				})}
+				require.NoError(t, k.importContract(ctx, addrs[i], &info, nil, history))
+			}
+			if i < len(models) {
+				require.NoError(t, k.importContractState(ctx, addrs[0], []types.Model{models[i]}))
+			}
+		}
+		var r result
+		pinned, err := Querier(k).PinnedCodes(ctx, &types.QueryPinnedCodesRequest{})
+		require.NoError(t, err)
+		r.pinned = pinned.CodeIDs
+		k.IterateContractsByCode(ctx, codeIDs[0], func(addr sdk.AccAddress) bool {
+			r.byCode = append(r.byCode, addr)
+			return false
+		})
+		k.IterateContractState(ctx, addrs[0], func(key, _ []byte) bool {
+			r.stateKeys = append(r.stateKeys, key)
+			return false
+		})
+		return r
+	}
+
+	exp := result{
+		pinned:    []uint64{1, 2, 3, 4},
+		byCode:    []sdk.AccAddress{addrs[2], addrs[1], addrs[0]},
+		stateKeys: [][]byte{{0x0}, []byte("a"), []byte("ab"), []byte("b")},
+	}
+	for name, order := range map[string][]int{
+		"ascending":  {0, 1, 2, 3},
+		"descending": {3, 2, 1, 0},
+		"mixed":      {2, 0, 3, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, exp, run(t, order))
+		})
+	}
+}
+
+func TestSetContractPaused(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	ctx, _ := parentCtx.CacheContext()
+	em := sdk.NewEventManager()
+	// when
+	require.NoError(t, k.SetContractPaused(ctx.WithEventManager(em), k.GetAuthority(), example.Contract, true))
+
+	// then
+	assert.True(t, k.IsContractPaused(ctx, example.Contract))
+	exp := sdk.Events{sdk.NewEvent(types.EventTypePauseContract, sdk.NewAttribute(types.AttributeKeyContractAddr, example.Contract.String()))}
+	assert.Equal(t, exp, em.Events())
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	assert.ErrorIs(t, err, types.ErrContractPaused)
+	_, err = k.Sudo(ctx, example.Contract, []byte(`{"steal_funds":{"recipient":"`+example.BeneficiaryAddr.String()+`","amount":[]}}`))
+	assert.ErrorIs(t, err, types.ErrContractPaused)
+	// queries remain allowed
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	assert.NoError(t, err)
+
+	// when
+	em = sdk.NewEventManager()
+	require.NoError(t, k.SetContractPaused(ctx.WithEventManager(em), k.GetAuthority(), example.Contract, false))
+
+	// then
+	assert.False(t, k.IsContractPaused(ctx, example.Contract))
+	exp = sdk.Events{sdk.NewEvent(types.EventTypeUnpauseContract, sdk.NewAttribute(types.AttributeKeyContractAddr, example.Contract.String()))}
+	assert.Equal(t, exp, em.Events())
+	_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	assert.NoError(t, err)
+
+	// and
+	err = k.SetContractPaused(parentCtx, RandomBech32AccountAddress(t), example.Contract, true)
+	assert.ErrorIs(t, err, types.ErrInvalid)
+	err = k.SetContractPaused(parentCtx, k.GetAuthority(), RandomAccountAddress(t), true)
+	assert.ErrorIs(t, err, types.ErrNoSuchContractFn(""))
+	assert.False(t, k.IsContractPaused(parentCtx, example.Contract))
+}
+
+func TestSetUploadFrozen(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	creator := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 1))
+
+	ctx, _ := parentCtx.CacheContext()
+	// when
+	require.NoError(t, k.SetUploadFrozen(ctx, k.GetAuthority(), true))
+
+	// then
+	assert.True(t, k.IsUploadFrozen(ctx))
+	_, _, err := keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	assert.ErrorIs(t, err, types.ErrUploadFrozen)
+	// existing contracts remain usable
+	_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	assert.NoError(t, err)
+
+	// when
+	require.NoError(t, k.SetUploadFrozen(ctx, k.GetAuthority(), false))
+
+	// then
+	assert.False(t, k.IsUploadFrozen(ctx))
+	_, _, err = keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	assert.NoError(t, err)
+
+	// and
+	err = k.SetUploadFrozen(parentCtx, RandomBech32AccountAddress(t), true)
+	assert.ErrorIs(t, err, types.ErrInvalid)
+	assert.False(t, k.IsUploadFrozen(parentCtx))
+}
+
+func TestRebindContractPort(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	const oldPortID = "myOldPort"
+	example := InstantiateReflectExampleContractWithPortID(t, parentCtx, keepers, oldPortID)
+	newPortID := PortIDForContract(example.Contract)
+	otherExample := InstantiateIBCReflectContract(t, parentCtx, keepers)
+	require.NoError(t, k.RebindContractPort(parentCtx, k.GetAuthority(), otherExample.Contract, "wasm.taken"))
+
+	specs := map[string]struct {
+		authority string
+		contract  sdk.AccAddress
+		portID    string
+		channel   *channeltypes.Channel
+		expErr    error
+	}{
+		"rebind": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    newPortID,
+		},
+		"rebind with closed channel": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    newPortID,
+			channel:   &channeltypes.Channel{State: channeltypes.CLOSED, ConnectionHops: []string{"connection-0"}},
+		},
+		"open channel on old port": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    newPortID,
+			channel:   &channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"}},
+			expErr:    types.ErrInvalid,
+		},
+		"rebind to custom port": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    "wasm.myNewPort",
+		},
+		"port without prefix": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    "myNewPort",
+			expErr:    types.ErrInvalid,
+		},
+		"port of other contract": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    PortIDForContract(RandomAccountAddress(t)),
+			expErr:    types.ErrInvalid,
+		},
+		"port bound to other contract": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    "wasm.taken",
+			expErr:    types.ErrDuplicate,
+		},
+		"invalid port id": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    "",
+			expErr:    types.ErrInvalid,
+		},
+		"same port id": {
+			authority: k.GetAuthority(),
+			contract:  example.Contract,
+			portID:    oldPortID,
+			expErr:    types.ErrDuplicate,
+		},
+		"unauthorized": {
+			authority: RandomBech32AccountAddress(t),
+			contract:  example.Contract,
+			portID:    newPortID,
+			expErr:    types.ErrInvalid,
+		},
+		"unknown contract": {
+			authority: k.GetAuthority(),
+			contract:  RandomAccountAddress(t),
+			portID:    newPortID,
+			expErr:    types.ErrNoSuchContractFn(""),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.channel != nil {
+				keepers.IBCKeeper.ChannelKeeper.SetChannel(ctx, oldPortID, "channel-0", *spec.channel)
+			}
+			// when
+			gotErr := k.RebindContractPort(ctx, spec.authority, spec.contract, spec.portID)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, oldPortID, k.GetContractInfo(ctx, example.Contract).IBCPortID)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.portID, k.GetContractInfo(ctx, example.Contract).IBCPortID)
+			gotAddr, ok := k.ContractByPortID(ctx, spec.portID)
+			require.True(t, ok)
+			assert.Equal(t, example.Contract, gotAddr)
+		})
+	}
+}
+
+func TestRebindContractPortRotation(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateIBCReflectContract(t, ctx, keepers)
+	derivedPortID := PortIDForContract(example.Contract)
+	require.Equal(t, derivedPortID, k.GetContractInfo(ctx, example.Contract).IBCPortID)
+
+	// rotate away from the derived port and between custom ports
+	for _, portID := range []string{"wasm.first", "wasm.second", derivedPortID} {
+		prevPortID := k.GetContractInfo(ctx, example.Contract).IBCPortID
+		require.NoError(t, k.RebindContractPort(ctx, k.GetAuthority(), example.Contract, portID))
+
+		gotAddr, ok := k.ContractByPortID(ctx, portID)
+		require.True(t, ok, portID)
+		assert.Equal(t, example.Contract, gotAddr)
+		_, ok = k.ContractByPortID(ctx, prevPortID)
+		assert.False(t, ok, prevPortID)
+	}
+	// the derived port does not need a mapping
+	assert.False(t, ctx.KVStore(keepers.WasmStoreKey).Has(types.GetContractByPortIDKey("wasm.second")))
+
+	// and a retired port can be taken by another contract
+	otherExample := InstantiateIBCReflectContract(t, ctx, keepers)
+	require.NoError(t, k.RebindContractPort(ctx, k.GetAuthority(), otherExample.Contract, "wasm.first"))
+	gotAddr, ok := k.ContractByPortID(ctx, "wasm.first")
+	require.True(t, ok)
+	assert.Equal(t, otherExample.Contract, gotAddr)
+}
+
+func TestContractStateVersion(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	otherContract := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	ctx, _ := parentCtx.CacheContext()
+	// default when not set
+	assert.Equal(t, uint64(0), k.GetContractStateVersion(ctx, example.Contract))
+
+	// when
+	require.NoError(t, k.SetContractStateVersion(ctx, example.Contract, 2))
+	// then
+	assert.Equal(t, uint64(2), k.GetContractStateVersion(ctx, example.Contract))
+	assert.Equal(t, uint64(0), k.GetContractStateVersion(ctx, otherContract.Contract))
+	// not visible in the contract store
+	assert.Nil(t, k.QueryRaw(ctx, example.Contract, types.GetContractStateVersionKey(example.Contract)))
+
+	// when overwritten
+	require.NoError(t, k.SetContractStateVersion(ctx, example.Contract, 3))
+	// then
+	assert.Equal(t, uint64(3), k.GetContractStateVersion(ctx, example.Contract))
+
+	// and
+	err := k.SetContractStateVersion(ctx, RandomAccountAddress(t), 1)
+	assert.ErrorIs(t, err, types.ErrNoSuchContractFn(""))
+}
+
+func TestSetCodeMetadata(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	authority, err := sdk.AccAddressFromBech32(k.GetAuthority())
+	require.NoError(t, err)
+	myMetadata := []byte(`{"source":"https://example.com/hackatom","builder":"cosmwasm/optimizer:0.16.0"}`)
+
+	specs := map[string]struct {
+		caller   sdk.AccAddress
+		codeID   uint64
+		metadata []byte
+		expErr   error
+	}{
+		"creator": {
+			caller:   example.CreatorAddr,
+			codeID:   example.CodeID,
+			metadata: myMetadata,
+		},
+		"authority": {
+			caller:   authority,
+			codeID:   example.CodeID,
+			metadata: myMetadata,
+		},
+		"max size": {
+			caller:   example.CreatorAddr,
+			codeID:   example.CodeID,
+			metadata: bytes.Repeat([]byte{1}, types.MaxCodeMetadataSize),
+		},
+		"unauthorized": {
+			caller:   RandomAccountAddress(t),
+			codeID:   example.CodeID,
+			metadata: myMetadata,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"oversize": {
+			caller:   example.CreatorAddr,
+			codeID:   example.CodeID,
+			metadata: bytes.Repeat([]byte{1}, types.MaxCodeMetadataSize+1),
+			expErr:   types.ErrLimit,
+		},
+		"unknown code": {
+			caller:   authority,
+			codeID:   999,
+			metadata: myMetadata,
+			expErr:   types.ErrNoSuchCodeFn(999),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			// when
+			gotErr := k.SetCodeMetadata(ctx, spec.caller, spec.codeID, spec.metadata)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Nil(t, k.GetCodeMetadata(ctx, spec.codeID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.metadata, k.GetCodeMetadata(ctx, spec.codeID))
+			// and removed with empty metadata
+			require.NoError(t, k.SetCodeMetadata(ctx, spec.caller, spec.codeID, nil))
+			assert.Nil(t, k.GetCodeMetadata(ctx, spec.codeID))
+		})
+	}
+}
+
+func TestCodeAndContractCount(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	assert.Equal(t, uint64(0), k.CodeCount(ctx))
+	assert.Equal(t, uint64(0), k.ContractCount(ctx))
+
+	// when code stored
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	StoreReflectContract(t, ctx, keepers)
+	// then
+	assert.Equal(t, uint64(2), k.CodeCount(ctx))
+	assert.Equal(t, uint64(0), k.ContractCount(ctx))
+
+	// when instantiated
+	initMsg := mustMarshal(t, HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)})
+	var contracts []sdk.AccAddress
+	for _, label := range []string{"first", "second"} {
+		addr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, label, nil)
+		require.NoError(t, err)
+		contracts = append(contracts, addr)
+	}
+	// then
+	assert.Equal(t, uint64(2), k.CodeCount(ctx))
+	assert.Equal(t, uint64(2), k.ContractCount(ctx))
+
+	// when an existing contract is replaced by a forced import
+	snapshot, err := k.ExportContract(ctx, contracts[0])
+	require.NoError(t, err)
+	require.NoError(t, k.ImportContract(ctx, contracts[1], snapshot, true))
+	// then
+	assert.Equal(t, uint64(2), k.CodeCount(ctx))
+	assert.Equal(t, uint64(2), k.ContractCount(ctx))
+
+	// when imported to a new address
+	require.NoError(t, k.ImportContract(ctx, RandomAccountAddress(t), snapshot, false))
+	// then
+	assert.Equal(t, uint64(2), k.CodeCount(ctx))
+	assert.Equal(t, uint64(3), k.ContractCount(ctx))
+}
+
+func TestContractStateEntryCount(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		models   []types.Model
+		expCount uint64
+		expErr   error
+	}{
+		"empty state": {
+			contract: example.Contract,
+		},
+		"with state": {
+			contract: example.Contract,
+			models:   []types.Model{{Key: []byte("a"), Value: []byte("1")}, {Key: []byte("b"), Value: []byte("2")}, {Key: []byte("c"), Value: []byte("3")}},
+			expCount: 3,
+		},
+		"unknown contract": {
+			contract: RandomAccountAddress(t),
+			expErr:   types.ErrNoSuchContractFn(""),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, k.importContractState(ctx, spec.contract, spec.models))
+
+			// when
+			gotCount, gotErr := k.ContractStateEntryCount(ctx, spec.contract)
+
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expCount, gotCount)
+		})
+	}
+}
+
+func TestContractInfoWithBalances(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	expInfo := *k.GetContractInfo(parentCtx, example.Contract)
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 100), sdk.NewInt64Coin("other", 1))
+
+	specs := map[string]struct {
+		contract    sdk.AccAddress
+		funds       sdk.Coins
+		expBalances sdk.Coins
+		expErr      error
+	}{
+		"funded contract": {
+			contract:    example.Contract,
+			funds:       funds,
+			expBalances: funds,
+		},
+		"unfunded contract": {
+			contract:    example.Contract,
+			expBalances: sdk.NewCoins(),
+		},
+		"nonexistent address": {
+			contract: RandomAccountAddress(t),
+			expErr:   types.ErrNoSuchContractFn(""),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if !spec.funds.IsZero() {
+				keepers.Faucet.Fund(ctx, spec.contract, spec.funds...)
+			}
+
+			// when
+			gotInfo, gotBalances, gotErr := k.ContractInfoWithBalances(ctx, spec.contract)
+
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, expInfo, gotInfo)
+			assert.Equal(t, spec.expBalances, gotBalances)
+		})
+	}
+}
+
+func TestEstimateStorageRent(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		models   []types.Model
+		perByte  sdkmath.Int
+		exp      sdk.Coin
+		expErr   error
+	}{
+		"empty state": {
+			contract: example.Contract,
+			perByte:  sdkmath.NewInt(3),
+			exp:      sdk.NewInt64Coin("stake", 0),
+		},
+		"with state": {
+			contract: example.Contract,
+			// 2+3 + 1+4 = 10 bytes
+			models:  []types.Model{{Key: []byte("ab"), Value: []byte("123")}, {Key: []byte("c"), Value: []byte("4567")}},
+			perByte: sdkmath.NewInt(3),
+			exp:     sdk.NewInt64Coin("stake", 30),
+		},
+		"zero rate": {
+			contract: example.Contract,
+			models:   []types.Model{{Key: []byte("ab"), Value: []byte("123")}},
+			perByte:  sdkmath.ZeroInt(),
+			exp:      sdk.NewInt64Coin("stake", 0),
+		},
+		"negative rate": {
+			contract: example.Contract,
+			perByte:  sdkmath.NewInt(-1),
+			expErr:   types.ErrInvalid,
+		},
+		"unknown contract": {
+			contract: RandomAccountAddress(t),
+			perByte:  sdkmath.NewInt(3),
+			expErr:   types.ErrNoSuchContractFn(""),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, k.importContractState(ctx, spec.contract, spec.models))
+
+			// when
+			got, gotErr := k.EstimateStorageRent(ctx, spec.contract, spec.perByte)
+
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestPurgeContractState(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	specs := map[string]struct {
+		authority string
+		funds     sdk.Coins
+		expErr    *errorsmod.Error
+	}{
+		"all state removed": {
+			authority: k.GetAuthority(),
+		},
+		"contract holds funds": {
+			authority: k.GetAuthority(),
+			funds:     sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			expErr:    types.ErrInvalid,
+		},
+		"unauthorized": {
+			authority: RandomAccountAddress(t).String(),
+			expErr:    types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			contractAddr := RandomAccountAddress(t)
+			models := []types.Model{{Key: []byte("a"), Value: []byte("1")}, {Key: []byte("b"), Value: []byte("2")}}
+			require.NoError(t, k.importContractState(ctx, contractAddr, models))
+			if !spec.funds.IsZero() {
+				keepers.Faucet.Fund(ctx, contractAddr, spec.funds...)
+			}
+
+			// when
+			gotErr := k.PurgeContractState(ctx, spec.authority, contractAddr)
+
+			// then
+			var gotModels []types.Model
+			k.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+				gotModels = append(gotModels, types.Model{Key: key, Value: value})
+				return false
+			})
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				assert.Len(t, gotModels, len(models))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Empty(t, gotModels)
+		})
+	}
+}
+
 func attrsToStringMap(attrs []abci.EventAttribute) map[string]string {
 	r := make(map[string]string, len(attrs))
 	for _, v := range attrs {
@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
@@ -14,6 +17,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
@@ -36,15 +40,50 @@ type replyer interface {
 	reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error)
 }
 
+// ReplyEventsFilter decides if the events emitted by a submessage are passed to the contract with the reply
+type ReplyEventsFilter func(msg wasmvmtypes.SubMsg) bool
+
+// DefaultReplyEventsFilter passes the events of wasm messages only.
+// Events from other modules are not guaranteed to be deterministic.
+func DefaultReplyEventsFilter(msg wasmvmtypes.SubMsg) bool {
+	return msg.Msg.Wasm != nil
+}
+
+// IBCTransferReplyData is the reply data of an IBC transfer submessage when enabled via WithIBCTransferReplyData.
+// It allows contracts to track the outgoing packet.
+type IBCTransferReplyData struct {
+	Sequence uint64 `json:"sequence"`
+	Channel  string `json:"channel"`
+}
+
+// UndelegateReplyData is the reply data of an undelegate submessage when enabled via WithUndelegateReplyData.
+// It allows contracts to schedule follow-up actions for when the unbonding completes.
+type UndelegateReplyData struct {
+	// CompletionTime is the unix time in nanoseconds when the tokens are released
+	CompletionTime wasmvmtypes.Uint64 `json:"completion_time"`
+}
+
 // MessageDispatcher coordinates message sending and submessage reply/ state commits
 type MessageDispatcher struct {
-	messenger Messenger
-	keeper    replyer
+	messenger   Messenger
+	keeper      replyer
+	replyEvents ReplyEventsFilter
+	// replaces the reply data of IBC transfer submessages with the IBCTransferReplyData
+	transferReplyData bool
+	// replaces the reply data of undelegate submessages with the UndelegateReplyData
+	undelegateReplyData bool
+	// max number of messages in a single contract response
+	maxMessages uint32
 }
 
 // NewMessageDispatcher constructor
 func NewMessageDispatcher(messenger Messenger, keeper replyer) *MessageDispatcher {
-	return &MessageDispatcher{messenger: messenger, keeper: keeper}
+	return &MessageDispatcher{
+		messenger:   messenger,
+		keeper:      keeper,
+		replyEvents: DefaultReplyEventsFilter,
+		maxMessages: types.DefaultMaxContractMessages,
+	}
 }
 
 // DispatchMessages sends all messages.
@@ -90,6 +129,9 @@ func (d MessageDispatcher) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr
 // DispatchSubmessages builds a sandbox to execute these messages and returns the execution result to the contract
 // that dispatched them, both on success as well as failure
 func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.SubMsg) ([]byte, error) {
+	if len(msgs) > int(d.maxMessages) {
+		return nil, errorsmod.Wrapf(types.ErrLimit, "contract messages: %d > %d", len(msgs), d.maxMessages)
+	}
 	var rsp []byte
 	for _, msg := range msgs {
 		switch msg.ReplyOn {
@@ -122,7 +164,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 			commit()
 			filteredEvents = filterEvents(append(em.Events(), events...))
 			ctx.EventManager().EmitEvents(filteredEvents)
-			if msg.Msg.Wasm == nil {
+			if !d.replyEvents(msg) {
 				filteredEvents = []sdk.Event{}
 			} else {
 				for _, e := range filteredEvents {
@@ -151,6 +193,16 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 			if len(data) > 0 {
 				responseData = data[0]
 			}
+			if d.transferReplyData && msg.Msg.IBC != nil && msg.Msg.IBC.Transfer != nil {
+				if bz, ok := ibcTransferReplyData(msg.Msg.IBC.Transfer.ChannelID, msgResponses); ok {
+					responseData = bz
+				}
+			}
+			if d.undelegateReplyData && msg.Msg.Staking != nil && msg.Msg.Staking.Undelegate != nil {
+				if bz, ok := undelegateReplyData(msgResponses); ok {
+					responseData = bz
+				}
+			}
 
 			// For msgResponses we flatten the nested list into a flat list. In the majority of cases
 			// we only expect one message to be emitted and one response per message. But it might be possible
@@ -201,6 +253,49 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 	return rsp, nil
 }
 
+// ibcTransferReplyData returns the json encoded IBCTransferReplyData for the transfer response in the given
+// message responses. When no transfer response is found, false is returned.
+func ibcTransferReplyData(channel string, msgResponses [][]*codectypes.Any) ([]byte, bool) {
+	var rsp ibctransfertypes.MsgTransferResponse
+	if !findMsgResponse(msgResponses, &rsp) {
+		return nil, false
+	}
+	bz, err := json.Marshal(IBCTransferReplyData{Sequence: rsp.Sequence, Channel: channel})
+	if err != nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// undelegateReplyData returns the json encoded UndelegateReplyData for the undelegate response in the given
+// message responses. When no undelegate response is found, false is returned.
+func undelegateReplyData(msgResponses [][]*codectypes.Any) ([]byte, bool) {
+	var rsp stakingtypes.MsgUndelegateResponse
+	if !findMsgResponse(msgResponses, &rsp) {
+		return nil, false
+	}
+	bz, err := json.Marshal(UndelegateReplyData{CompletionTime: wasmvmtypes.Uint64(rsp.CompletionTime.UnixNano())})
+	if err != nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// findMsgResponse unmarshals the first message response with the type url of the given response into it.
+// Returns false when no such response is found or it can not be unmarshalled.
+func findMsgResponse(msgResponses [][]*codectypes.Any, rsp proto.Message) bool {
+	typeURL := sdk.MsgTypeURL(rsp)
+	for _, singleMsgResponses := range msgResponses {
+		for _, r := range singleMsgResponses {
+			if r == nil || r.TypeUrl != typeURL {
+				continue
+			}
+			return proto.Unmarshal(r.Value, rsp) == nil
+		}
+	}
+	return false
+}
+
 // Issue #759 - we don't return error string for worries of non-determinism
 func redactError(err error) error {
 	// Do not redact system errors
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -16,8 +19,10 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 func TestDispatchSubmessages(t *testing.T) {
@@ -448,6 +453,310 @@ func TestDispatchSubmessages(t *testing.T) {
 	}
 }
 
+func TestDispatchSubmessagesReplyEvents(t *testing.T) {
+	specs := map[string]struct {
+		filter    ReplyEventsFilter
+		expEvents []wasmvmtypes.Event
+	}{
+		"default filter - events not passed": {
+			filter:    DefaultReplyEventsFilter,
+			expEvents: []wasmvmtypes.Event{},
+		},
+		"custom filter enabled": {
+			filter:    func(msg wasmvmtypes.SubMsg) bool { return msg.ID == 1 },
+			expEvents: []wasmvmtypes.Event{{Type: "myEvent", Attributes: []wasmvmtypes.EventAttribute{{Key: "foo", Value: "bar"}}}},
+		},
+		"custom filter disabled": {
+			filter:    func(msg wasmvmtypes.SubMsg) bool { return msg.ID != 1 },
+			expEvents: []wasmvmtypes.Event{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotEvents []wasmvmtypes.Event
+			replyer := &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					gotEvents = reply.Result.Ok.Events
+					return nil, nil
+				},
+			}
+			msgHandler := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+					return []sdk.Event{sdk.NewEvent("myEvent", sdk.NewAttribute("foo", "bar"))}, nil, [][]*codectypes.Any{}, nil
+				},
+			}
+			var mockStore wasmtesting.MockCommitMultiStore
+			ctx := sdk.Context{}.WithMultiStore(&mockStore).
+				WithGasMeter(storetypes.NewGasMeter(100)).
+				WithEventManager(sdk.NewEventManager()).WithLogger(log.NewTestLogger(t))
+			d := NewMessageDispatcher(msgHandler, replyer)
+			d.replyEvents = spec.filter
+			msgs := []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplySuccess, Msg: wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{}}}}
+
+			// when
+			_, gotErr := d.DispatchSubmessages(ctx, RandomAccountAddress(t), "any_port", msgs)
+
+			// then
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expEvents, gotEvents)
+		})
+	}
+}
+
+// The reply settings of sub messages decide if a contract response is executed atomically or best effort.
+// With no reply on error, the first failure aborts the whole response. With reply on error, the failed
+// message is reverted and the following messages are still executed.
+func TestDispatchSubmessagesFailureModes(t *testing.T) {
+	specs := map[string]struct {
+		subMsg        wasmvmtypes.SubMsg
+		expErr        bool
+		expDispatched int
+		expCommits    []bool
+		expReplies    int
+	}{
+		"atomic - stop on first failure": {
+			subMsg:        wasmvmtypes.SubMsg{ReplyOn: wasmvmtypes.ReplyNever},
+			expErr:        true,
+			expDispatched: 2,
+			expCommits:    []bool{true, false},
+		},
+		"atomic with reply on success - stop on first failure": {
+			subMsg:        wasmvmtypes.SubMsg{ReplyOn: wasmvmtypes.ReplySuccess},
+			expErr:        true,
+			expDispatched: 2,
+			expCommits:    []bool{true, false},
+			expReplies:    1,
+		},
+		"independent - best effort": {
+			subMsg:        wasmvmtypes.SubMsg{ReplyOn: wasmvmtypes.ReplyError},
+			expDispatched: 3,
+			expCommits:    []bool{true, false, true},
+			expReplies:    1,
+		},
+		"independent with reply always - best effort": {
+			subMsg:        wasmvmtypes.SubMsg{ReplyOn: wasmvmtypes.ReplyAlways},
+			expDispatched: 3,
+			expCommits:    []bool{true, false, true},
+			expReplies:    3,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var dispatched, replies int
+			msgHandler := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+					dispatched++
+					if dispatched == 2 {
+						return nil, nil, nil, errors.New("test, ignore")
+					}
+					return nil, nil, [][]*codectypes.Any{}, nil
+				},
+			}
+			replyer := &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					replies++
+					return nil, nil
+				},
+			}
+			var mockStore wasmtesting.MockCommitMultiStore
+			ctx := sdk.Context{}.WithMultiStore(&mockStore).
+				WithGasMeter(storetypes.NewGasMeter(100)).
+				WithEventManager(sdk.NewEventManager()).WithLogger(log.NewTestLogger(t))
+			d := NewMessageDispatcher(msgHandler, replyer)
+			msgs := make([]wasmvmtypes.SubMsg, 3)
+			for i := range msgs {
+				msgs[i] = spec.subMsg
+				msgs[i].ID = uint64(i)
+				msgs[i].Msg = wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{}}
+			}
+
+			// when
+			_, gotErr := d.DispatchSubmessages(ctx, RandomAccountAddress(t), "any_port", msgs)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			assert.Equal(t, spec.expDispatched, dispatched)
+			assert.Equal(t, spec.expCommits, mockStore.Committed)
+			assert.Equal(t, spec.expReplies, replies)
+		})
+	}
+}
+
+func TestDispatchSubmessagesMaxMessages(t *testing.T) {
+	specs := map[string]struct {
+		msgCount int
+		expErr   error
+	}{
+		"below limit": {
+			msgCount: 1,
+		},
+		"at limit": {
+			msgCount: 2,
+		},
+		"over limit": {
+			msgCount: 3,
+			expErr:   types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var dispatched int
+			msgHandler := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+					dispatched++
+					return nil, nil, [][]*codectypes.Any{}, nil
+				},
+			}
+			var mockStore wasmtesting.MockCommitMultiStore
+			ctx := sdk.Context{}.WithMultiStore(&mockStore).
+				WithGasMeter(storetypes.NewGasMeter(100)).
+				WithEventManager(sdk.NewEventManager()).WithLogger(log.NewTestLogger(t))
+			d := NewMessageDispatcher(msgHandler, &mockReplyer{})
+			d.maxMessages = 2
+			msgs := make([]wasmvmtypes.SubMsg, spec.msgCount)
+			for i := range msgs {
+				msgs[i] = wasmvmtypes.SubMsg{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{}}}
+			}
+
+			// when
+			_, gotErr := d.DispatchSubmessages(ctx, RandomAccountAddress(t), "any_port", msgs)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, 0, dispatched)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.msgCount, dispatched)
+		})
+	}
+}
+
+func TestDispatchSubmessagesIBCTransferReplyData(t *testing.T) {
+	transferMsg := wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{
+		ChannelID: "channel-7",
+		ToAddress: "to",
+		Amount:    wasmvmtypes.NewCoin(1, "denom"),
+	}}}
+	transferRsp := must(codectypes.NewAnyWithValue(&ibctransfertypes.MsgTransferResponse{Sequence: 42}))
+	protoData := must(proto.Marshal(&ibctransfertypes.MsgTransferResponse{Sequence: 42}))
+	specs := map[string]struct {
+		enabled bool
+		msg     wasmvmtypes.CosmosMsg
+		expData []byte
+	}{
+		"disabled": {
+			msg:     transferMsg,
+			expData: protoData,
+		},
+		"enabled": {
+			enabled: true,
+			msg:     transferMsg,
+			expData: []byte(`{"sequence":42,"channel":"channel-7"}`),
+		},
+		"enabled - other message": {
+			enabled: true,
+			msg:     wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{}},
+			expData: protoData,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotData []byte
+			replyer := &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					gotData = reply.Result.Ok.Data
+					return nil, nil
+				},
+			}
+			msgHandler := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+					return nil, [][]byte{protoData}, [][]*codectypes.Any{{transferRsp}}, nil
+				},
+			}
+			var mockStore wasmtesting.MockCommitMultiStore
+			ctx := sdk.Context{}.WithMultiStore(&mockStore).
+				WithGasMeter(storetypes.NewGasMeter(100)).
+				WithEventManager(sdk.NewEventManager()).WithLogger(log.NewTestLogger(t))
+			d := NewMessageDispatcher(msgHandler, replyer)
+			d.transferReplyData = spec.enabled
+			msgs := []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplySuccess, Msg: spec.msg}}
+
+			// when
+			_, gotErr := d.DispatchSubmessages(ctx, RandomAccountAddress(t), "any_port", msgs)
+
+			// then
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expData, gotData)
+		})
+	}
+}
+
+func TestDispatchSubmessagesUndelegateReplyData(t *testing.T) {
+	undelegateMsg := wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Undelegate: &wasmvmtypes.UndelegateMsg{
+		Validator: "validator",
+		Amount:    wasmvmtypes.NewCoin(1, "denom"),
+	}}}
+	completionTime := time.Unix(1_700_000_000, 123).UTC()
+	undelegateRsp := must(codectypes.NewAnyWithValue(&stakingtypes.MsgUndelegateResponse{CompletionTime: completionTime, Amount: sdk.NewInt64Coin("denom", 1)}))
+	protoData := must(proto.Marshal(&stakingtypes.MsgUndelegateResponse{CompletionTime: completionTime, Amount: sdk.NewInt64Coin("denom", 1)}))
+	specs := map[string]struct {
+		enabled bool
+		msg     wasmvmtypes.CosmosMsg
+		expData []byte
+	}{
+		"disabled": {
+			msg:     undelegateMsg,
+			expData: protoData,
+		},
+		"enabled": {
+			enabled: true,
+			msg:     undelegateMsg,
+			expData: []byte(`{"completion_time":"1700000000000000123"}`),
+		},
+		"enabled - other message": {
+			enabled: true,
+			msg:     wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{}},
+			expData: protoData,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotData []byte
+			replyer := &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					gotData = reply.Result.Ok.Data
+					return nil, nil
+				},
+			}
+			msgHandler := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+					return nil, [][]byte{protoData}, [][]*codectypes.Any{{undelegateRsp}}, nil
+				},
+			}
+			var mockStore wasmtesting.MockCommitMultiStore
+			ctx := sdk.Context{}.WithMultiStore(&mockStore).
+				WithGasMeter(storetypes.NewGasMeter(100)).
+				WithEventManager(sdk.NewEventManager()).WithLogger(log.NewTestLogger(t))
+			d := NewMessageDispatcher(msgHandler, replyer)
+			d.undelegateReplyData = spec.enabled
+			msgs := []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplySuccess, Msg: spec.msg}}
+
+			// when
+			_, gotErr := d.DispatchSubmessages(ctx, RandomAccountAddress(t), "any_port", msgs)
+
+			// then
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expData, gotData)
+		})
+	}
+}
+
 type mockReplyer struct {
 	replyFn func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	"github.com/prometheus/client_golang/prometheus"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -164,6 +165,97 @@ func WithMaxCallDepth(m uint32) Option {
 	})
 }
 
+// WithPortIDPrefix sets a custom prefix for the IBC port ids that are bound to contracts. The default is "wasm.".
+// The wasm IBC handler must be routed for the prefix. It must not be changed on a running chain as the existing
+// port ids would not resolve to their contracts anymore.
+func WithPortIDPrefix(prefix string) Option {
+	if err := host.PortIdentifierValidator(prefix); err != nil {
+		panic(err)
+	}
+	return optsFn(func(k *Keeper) {
+		k.portIDPrefix = prefix
+	})
+}
+
+// WithMaxContractMessages overwrites the default limit for the number of messages a contract
+// can return in a single response. Responses with more messages are rejected. The limit must not be zero.
+func WithMaxContractMessages(m uint32) Option {
+	if m == 0 {
+		panic("must not be zero")
+	}
+	return optsFn(func(k *Keeper) {
+		k.maxContractMessages = m
+	})
+}
+
+// WithReplyGasLimit sets the max gas that a single reply call of a contract can consume, independent of
+// the remaining tx gas. The limit is in sdk gas and applies to the contract execution only. Replies
+// exceeding it fail with ErrReplyGasLimit. A zero limit removes the ceiling, which is the default.
+func WithReplyGasLimit(limit uint64) Option {
+	return optsFn(func(k *Keeper) {
+		k.replyGasLimit = limit
+	})
+}
+
+// WithCallDepthGasCost charges gas for every wasm message dispatched by a contract.
+// The amount is base + perLevel * call depth, so that nested contract calls become more expensive.
+func WithCallDepthGasCost(base, perLevel uint64) Option {
+	return optsFn(func(k *Keeper) {
+		k.callDepthGasBase = base
+		k.callDepthGasPerLevel = perLevel
+	})
+}
+
+// WithReplyEventsFilter overwrites the default filter that decides per submessage if the emitted events
+// are passed to the contract with the reply. By default, only events of wasm messages are included.
+// Only enable this for messages with deterministic events.
+func WithReplyEventsFilter(f ReplyEventsFilter) Option {
+	return optsFn(func(k *Keeper) {
+		k.replyEventsFilter = f
+	})
+}
+
+// WithIBCTransferReplyData replaces the reply data of IBC transfer submessages with a json encoded
+// IBCTransferReplyData that contains the packet sequence and source channel. By default, the reply data
+// is the protobuf encoded MsgTransferResponse.
+func WithIBCTransferReplyData() Option {
+	return optsFn(func(k *Keeper) {
+		k.ibcTransferReplyData = true
+	})
+}
+
+// WithUndelegateReplyData replaces the reply data of undelegate submessages with a json encoded
+// UndelegateReplyData that contains the unbonding completion time. By default, the reply data
+// is the protobuf encoded MsgUndelegateResponse.
+func WithUndelegateReplyData() Option {
+	return optsFn(func(k *Keeper) {
+		k.undelegateReplyData = true
+	})
+}
+
+// WithPreExecuteHooks adds hooks that are run in the given order before a contract is executed.
+// The first hook that returns an error aborts the execution.
+func WithPreExecuteHooks(hooks ...PreExecuteHook) Option {
+	return optsFn(func(k *Keeper) {
+		k.preExecuteHooks = append(k.preExecuteHooks, hooks...)
+	})
+}
+
+// WithMigrationHooks adds hooks that are run in the given order after a contract was migrated successfully.
+// Hook errors are logged only, unless WithMigrationHookErrorsAbort is set.
+func WithMigrationHooks(hooks ...MigrationHook) Option {
+	return optsFn(func(k *Keeper) {
+		k.migrationHooks = append(k.migrationHooks, hooks...)
+	})
+}
+
+// WithMigrationHookErrorsAbort aborts a migration with the error of the first failing migration hook.
+func WithMigrationHookErrorsAbort() Option {
+	return optsFn(func(k *Keeper) {
+		k.migrationHookErrorsAbort = true
+	})
+}
+
 // WithAcceptedAccountTypesOnContractInstantiation sets the accepted account types. Account types of this list won't be overwritten or cause a failure
 // when they exist for an address on contract instantiation.
 //
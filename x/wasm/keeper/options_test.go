@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +14,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -122,6 +125,70 @@ func TestConstructorOptions(t *testing.T) {
 				assert.Equal(t, uint32(1), k.maxCallDepth)
 			},
 		},
+		"max contract messages": {
+			srcOpt: WithMaxContractMessages(1),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, uint32(1), k.maxContractMessages)
+			},
+		},
+		"reply gas limit": {
+			srcOpt: WithReplyGasLimit(1),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, uint64(1), k.replyGasLimit)
+			},
+		},
+		"call depth gas cost": {
+			srcOpt: WithCallDepthGasCost(1, 2),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, uint64(1), k.callDepthGasBase)
+				assert.Equal(t, uint64(2), k.callDepthGasPerLevel)
+				require.IsType(t, callDepthMessageHandler{}, k.messenger)
+				messenger, _ := k.messenger.(callDepthMessageHandler)
+				assert.Equal(t, uint64(1), messenger.DepthGasBase)
+				assert.Equal(t, uint64(2), messenger.DepthGasPerLevel)
+			},
+		},
+		"reply events filter": {
+			srcOpt: WithReplyEventsFilter(func(wasmvmtypes.SubMsg) bool { return true }),
+			verify: func(t *testing.T, k Keeper) {
+				require.NotNil(t, k.replyEventsFilter)
+				assert.True(t, k.replyEventsFilter(wasmvmtypes.SubMsg{}))
+			},
+		},
+		"ibc transfer reply data": {
+			srcOpt: WithIBCTransferReplyData(),
+			verify: func(t *testing.T, k Keeper) {
+				assert.True(t, k.ibcTransferReplyData)
+			},
+		},
+		"undelegate reply data": {
+			srcOpt: WithUndelegateReplyData(),
+			verify: func(t *testing.T, k Keeper) {
+				assert.True(t, k.undelegateReplyData)
+			},
+		},
+		"pre execute hooks": {
+			srcOpt: WithPreExecuteHooks(PreExecuteHookFn(func(context.Context, sdk.AccAddress, sdk.AccAddress, []byte, sdk.Coins) error {
+				return nil
+			})),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Len(t, k.preExecuteHooks, 1)
+			},
+		},
+		"migration hooks": {
+			srcOpt: WithMigrationHooks(MigrationHookFn(func(context.Context, sdk.AccAddress, uint64, uint64) error {
+				return nil
+			})),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Len(t, k.migrationHooks, 1)
+			},
+		},
+		"migration hook errors abort": {
+			srcOpt: WithMigrationHookErrorsAbort(),
+			verify: func(t *testing.T, k Keeper) {
+				assert.True(t, k.migrationHookErrorsAbort)
+			},
+		},
 		"accepted account types": {
 			srcOpt: WithAcceptedAccountTypesOnContractInstantiation(&authtypes.BaseAccount{}, &vestingtypes.ContinuousVestingAccount{}),
 			verify: func(t *testing.T, k Keeper) {
@@ -138,6 +205,12 @@ func TestConstructorOptions(t *testing.T) {
 				assert.Equal(t, VestingCoinBurner{}, k.accountPruner)
 			},
 		},
+		"port id prefix": {
+			srcOpt: WithPortIDPrefix("myapp."),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, "myapp.", k.portIDPrefix)
+			},
+		},
 		"gov propagation": {
 			srcOpt: WithGovSubMsgAuthZPropagated(types.AuthZActionInstantiate, types.AuthZActionMigrateContract),
 			verify: func(t *testing.T, k Keeper) {
@@ -161,6 +234,10 @@ func TestConstructorOptions(t *testing.T) {
 	}
 }
 
+func TestWithMaxContractMessagesRejectsZero(t *testing.T) {
+	assert.Panics(t, func() { WithMaxContractMessages(0) })
+}
+
 func setAPIDefaults() {
 	costHumanize = DefaultGasCostHumanAddress * types.DefaultGasMultiplier
 	costCanonical = DefaultGasCostCanonicalAddress * types.DefaultGasMultiplier
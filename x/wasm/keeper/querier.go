@@ -309,6 +309,54 @@ func (q GrpcQuerier) CodeInfo(c context.Context, req *types.QueryCodeInfoRequest
 	}, nil
 }
 
+// CodeMetadata returns the metadata attached to a code
+func (q GrpcQuerier) CodeMetadata(c context.Context, req *types.QueryCodeMetadataRequest) (*types.QueryCodeMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CodeId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "code id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if q.keeper.GetCodeInfo(ctx, req.CodeId) == nil {
+		return nil, types.ErrNoSuchCodeFn(req.CodeId).Wrapf("code id %d", req.CodeId)
+	}
+	metadata, err := q.storeService.OpenKVStore(ctx).Get(types.GetCodeMetadataKey(req.CodeId))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodeMetadataResponse{Metadata: metadata}, nil
+}
+
+// contractBalancesSource is implemented by keepers that can return the balances of a contract
+type contractBalancesSource interface {
+	ContractInfoWithBalances(ctx context.Context, contractAddress sdk.AccAddress) (types.ContractInfo, sdk.Coins, error)
+}
+
+// ContractInfoWithBalances returns the contract info together with all balances of the contract
+func (q GrpcQuerier) ContractInfoWithBalances(c context.Context, req *types.QueryContractInfoWithBalancesRequest) (*types.QueryContractInfoWithBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	source, ok := q.keeper.(contractBalancesSource)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "contract balances not supported")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	info, balances, err := source.ContractInfoWithBalances(sdk.UnwrapSDKContext(c), contractAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractInfoWithBalancesResponse{
+		Address:      contractAddr.String(),
+		ContractInfo: info,
+		Balances:     balances,
+	}, nil
+}
+
 func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper types.ViewKeeper) (*types.QueryContractInfoResponse, error) {
 	info := keeper.GetContractInfo(ctx, addr)
 	if info == nil {
@@ -422,6 +470,40 @@ func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContr
 	}, nil
 }
 
+// ContractsByAdmin lists all contracts administered by the given admin, ordered by address
+func (q GrpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	adminAddress, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetContractsByAdminPrefix(adminAddress))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contracts = append(contracts, sdk.AccAddress(key).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByAdminResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
 // max limit to pagination queries
 const maxResultEntries = 100
 
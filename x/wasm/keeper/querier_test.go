@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -876,6 +878,103 @@ func TestQueryCodeInfo(t *testing.T) {
 	}
 }
 
+func TestQueryCodeMetadata(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	withMetadata := StoreRandomContract(t, ctx, keepers, &mock)
+	withoutMetadata := StoreRandomContract(t, ctx, keepers, &mock)
+	require.NoError(t, k.SetCodeMetadata(ctx, withMetadata.CreatorAddr, withMetadata.CodeID, []byte(`{"source":"https://example.com"}`)))
+
+	specs := map[string]struct {
+		srcQuery    *types.QueryCodeMetadataRequest
+		expMetadata []byte
+		expErr      error
+	}{
+		"with metadata": {
+			srcQuery:    &types.QueryCodeMetadataRequest{CodeId: withMetadata.CodeID},
+			expMetadata: []byte(`{"source":"https://example.com"}`),
+		},
+		"without metadata": {
+			srcQuery: &types.QueryCodeMetadataRequest{CodeId: withoutMetadata.CodeID},
+		},
+		"unknown code": {
+			srcQuery: &types.QueryCodeMetadataRequest{CodeId: 99},
+			expErr:   types.ErrNoSuchCodeFn(99),
+		},
+		"empty code id": {
+			srcQuery: &types.QueryCodeMetadataRequest{},
+			expErr:   types.ErrInvalid,
+		},
+		"nil req": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	q := Querier(k)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.CodeMetadata(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMetadata, got.Metadata)
+		})
+	}
+}
+
+func TestQueryContractInfoWithBalances(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 100), sdk.NewInt64Coin("other", 1))
+	keepers.Faucet.Fund(ctx, example.Contract, funds...)
+
+	specs := map[string]struct {
+		srcQuery *types.QueryContractInfoWithBalancesRequest
+		expRsp   *types.QueryContractInfoWithBalancesResponse
+		expErr   error
+	}{
+		"found": {
+			srcQuery: &types.QueryContractInfoWithBalancesRequest{Address: example.Contract.String()},
+			expRsp: &types.QueryContractInfoWithBalancesResponse{
+				Address:      example.Contract.String(),
+				ContractInfo: *k.GetContractInfo(ctx, example.Contract),
+				Balances:     funds,
+			},
+		},
+		"not found": {
+			srcQuery: &types.QueryContractInfoWithBalancesRequest{Address: RandomBech32AccountAddress(t)},
+			expErr:   types.ErrNoSuchContractFn(""),
+		},
+		"invalid address": {
+			srcQuery: &types.QueryContractInfoWithBalancesRequest{Address: "not a bech32 address"},
+			expErr:   errors.New("decoding bech32 failed"),
+		},
+		"nil req": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	q := Querier(k)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.ContractInfoWithBalances(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, got)
+		})
+	}
+}
+
 func TestQueryCode(t *testing.T) {
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -1098,6 +1197,92 @@ func TestQueryContractsByCreatorList(t *testing.T) {
 	}
 }
 
+func TestQueryContractsByAdminList(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := StoreRandomContract(t, ctx, keepers, &mock)
+	admin := RandomAccountAddress(t)
+
+	var allExpectedContracts []string
+	for i := 0; i < 5; i++ {
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, admin, []byte("{}"), fmt.Sprintf("contract %d", i), nil)
+		require.NoError(t, err)
+		allExpectedContracts = append(allExpectedContracts, contract.String())
+	}
+	// contracts without admin or with another admin are not returned
+	_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte("{}"), "no admin", nil)
+	require.NoError(t, err)
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, []byte("{}"), "other admin", nil)
+	require.NoError(t, err)
+	// ordered by address
+	slices.SortFunc(allExpectedContracts, func(a, b string) int {
+		return bytes.Compare(sdk.MustAccAddressFromBech32(a), sdk.MustAccAddressFromBech32(b))
+	})
+
+	specs := map[string]struct {
+		srcQuery        *types.QueryContractsByAdminRequest
+		expContractAddr []string
+		expErr          error
+	}{
+		"query all": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: admin.String(),
+			},
+			expContractAddr: allExpectedContracts,
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: admin.String(),
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expErr: errLegacyPaginationUnsupported,
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: admin.String(),
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			expContractAddr: allExpectedContracts[0:1],
+		},
+		"unknown admin": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: RandomBech32AccountAddress(t),
+			},
+			expContractAddr: []string{},
+		},
+		"nil admin": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				Pagination: &query.PageRequest{},
+			},
+			expErr: errors.New("empty address string is not allowed"),
+		},
+		"nil req": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, gotErr := q.ContractsByAdmin(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expContractAddr, got.ContractAddresses)
+		})
+	}
+}
+
 func fromBase64(s string) []byte {
 	r, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
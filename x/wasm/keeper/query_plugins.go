@@ -9,14 +9,19 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/gogoproto/proto"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -215,20 +220,14 @@ func BankQuerier(bankKeeper types.BankViewKeeper) func(ctx sdk.Context, request
 			}
 			coin := bankKeeper.GetBalance(ctx, addr, request.Balance.Denom)
 			res := wasmvmtypes.BalanceResponse{
-				Amount: wasmvmtypes.Coin{
-					Denom:  coin.Denom,
-					Amount: coin.Amount.String(),
-				},
+				Amount: ConvertSdkCoinToWasmCoin(coin),
 			}
 			return json.Marshal(res)
 		}
 		if request.Supply != nil {
 			coin := bankKeeper.GetSupply(ctx, request.Supply.Denom)
 			res := wasmvmtypes.SupplyResponse{
-				Amount: wasmvmtypes.Coin{
-					Denom:  coin.Denom,
-					Amount: coin.Amount.String(),
-				},
+				Amount: ConvertSdkCoinToWasmCoin(coin),
 			}
 			return json.Marshal(res)
 		}
@@ -261,6 +260,53 @@ func NoCustomQuerier(sdk.Context, json.RawMessage) ([]byte, error) {
 	return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
 }
 
+type supplySource interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+}
+
+// TotalSupplyQuery is the custom query request handled by the TotalSupplyQuerier.
+// When the denom is empty, the supply of all denoms is returned starting from the optional key.
+type TotalSupplyQuery struct {
+	TotalSupply *struct {
+		Denom string `json:"denom,omitempty"`
+		Key   []byte `json:"key,omitempty"`
+	} `json:"total_supply,omitempty"`
+}
+
+// TotalSupplyResponse is the response to a TotalSupplyQuery
+type TotalSupplyResponse struct {
+	Supply wasmvmtypes.Array[wasmvmtypes.Coin] `json:"supply"`
+	// NextKey is set when more results are available
+	NextKey []byte `json:"next_key,omitempty"`
+}
+
+// TotalSupplyQuerier is a custom querier that returns the total supply of a denom or of all denoms.
+// The number of results in full set mode is capped by maxResults.
+func TotalSupplyQuerier(bankKeeper supplySource, maxResults uint64) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req TotalSupplyQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.TotalSupply == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		if req.TotalSupply.Denom != "" {
+			coin := bankKeeper.GetSupply(ctx, req.TotalSupply.Denom)
+			return json.Marshal(TotalSupplyResponse{
+				Supply: wasmvmtypes.Array[wasmvmtypes.Coin]{ConvertSdkCoinToWasmCoin(coin)},
+			})
+		}
+		coins, pageRes, err := bankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Key: req.TotalSupply.Key, Limit: maxResults})
+		if err != nil {
+			return nil, err
+		}
+		res := TotalSupplyResponse{Supply: ConvertSdkCoinsToWasmCoins(coins)}
+		if pageRes != nil {
+			res.NextKey = pageRes.NextKey
+		}
+		return json.Marshal(res)
+	}
+}
+
 func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper) func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 	return func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 		if request.PortID != nil {
@@ -337,6 +383,59 @@ func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper)
 	}
 }
 
+type connectionSource interface {
+	Connections(ctx context.Context, req *connectiontypes.QueryConnectionsRequest) (*connectiontypes.QueryConnectionsResponse, error)
+}
+
+// ConnectionsQuery is the custom query request handled by the ConnectionsQuerier.
+// Results start from the optional key returned as next key by a previous query.
+type ConnectionsQuery struct {
+	Connections *struct {
+		Key []byte `json:"key,omitempty"`
+	} `json:"connections,omitempty"`
+}
+
+// ConnectionsResponse is the response to a ConnectionsQuery
+type ConnectionsResponse struct {
+	Connections []IBCConnection `json:"connections"`
+	// NextKey is set when more results are available
+	NextKey []byte `json:"next_key,omitempty"`
+}
+
+// IBCConnection is an open IBC connection with the light client it is built on
+type IBCConnection struct {
+	ConnectionID string `json:"connection_id"`
+	ClientID     string `json:"client_id"`
+}
+
+// ConnectionsQuerier is a custom querier that returns the open IBC connections of the chain.
+// Not more than maxResults connections are loaded per page.
+func ConnectionsQuerier(k connectionSource, maxResults uint64) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req ConnectionsQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.Connections == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		got, err := k.Connections(ctx, &connectiontypes.QueryConnectionsRequest{
+			Pagination: &query.PageRequest{Key: req.Connections.Key, Limit: maxResults},
+		})
+		if err != nil {
+			return nil, err
+		}
+		res := ConnectionsResponse{Connections: make([]IBCConnection, 0, len(got.Connections))}
+		for _, c := range got.Connections {
+			if c.State != connectiontypes.OPEN {
+				continue
+			}
+			res.Connections = append(res.Connections, IBCConnection{ConnectionID: c.Id, ClientID: c.ClientId})
+		}
+		if got.Pagination != nil {
+			res.NextKey = got.Pagination.NextKey
+		}
+		return json.Marshal(res)
+	}
+}
+
 // RejectGrpcQuerier is a querier that rejects all gRPC queries.
 //
 // Use AcceptListGrpcQuerier instead to create a list of accepted query types.
@@ -537,6 +636,109 @@ func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKee
 	}
 }
 
+type validatorSource interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, err error)
+}
+
+// ValidatorInfoQuery is the custom query request handled by the ValidatorInfoQuerier
+type ValidatorInfoQuery struct {
+	ValidatorInfo *struct {
+		Address string `json:"address"`
+	} `json:"validator_info,omitempty"`
+}
+
+// ValidatorInfoResponse is the response to a ValidatorInfoQuery
+type ValidatorInfoResponse struct {
+	Address    string `json:"address"`
+	Commission string `json:"commission"`
+	// Status is the bond status name, for example BOND_STATUS_BONDED
+	Status string `json:"status"`
+	Tokens string `json:"tokens"`
+}
+
+// ValidatorInfoQuerier is a custom querier that returns the commission, bond status and tokens of a validator
+// in a single query.
+func ValidatorInfoQuerier(keeper validatorSource) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req ValidatorInfoQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.ValidatorInfo == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorInfo.Address)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorInfo.Address)
+		}
+		v, err := keeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, req.ValidatorInfo.Address)
+		}
+		return json.Marshal(ValidatorInfoResponse{
+			Address:    v.OperatorAddress,
+			Commission: v.Commission.Rate.String(),
+			Status:     v.Status.String(),
+			Tokens:     v.Tokens.String(),
+		})
+	}
+}
+
+type unbondingDelegationSource interface {
+	GetUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.UnbondingDelegation, error)
+}
+
+// UnbondingDelegationsQuery is the custom query request handled by the UnbondingDelegationsQuerier
+type UnbondingDelegationsQuery struct {
+	UnbondingDelegations *struct {
+		Delegator string `json:"delegator"`
+	} `json:"unbonding_delegations,omitempty"`
+}
+
+// UnbondingDelegationsResponse is the response to an UnbondingDelegationsQuery
+type UnbondingDelegationsResponse struct {
+	Entries []UnbondingDelegationEntry `json:"entries"`
+}
+
+// UnbondingDelegationEntry is a single pending unbonding of a delegator. Amounts are in the bond denom.
+type UnbondingDelegationEntry struct {
+	Validator      string `json:"validator"`
+	CreationHeight int64  `json:"creation_height"`
+	// CompletionTime is the unix time in nanoseconds when the tokens are released
+	CompletionTime wasmvmtypes.Uint64 `json:"completion_time"`
+	InitialBalance string             `json:"initial_balance"`
+	Balance        string             `json:"balance"`
+}
+
+// UnbondingDelegationsQuerier is a custom querier that returns the pending unbonding entries of a delegator.
+// Not more than maxResults unbonding delegations (validator pairs) are loaded. An empty list is returned when none exist.
+func UnbondingDelegationsQuerier(keeper unbondingDelegationSource, maxResults uint16) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req UnbondingDelegationsQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.UnbondingDelegations == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		delegator, err := sdk.AccAddressFromBech32(req.UnbondingDelegations.Delegator)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, req.UnbondingDelegations.Delegator)
+		}
+		ubds, err := keeper.GetUnbondingDelegations(ctx, delegator, maxResults)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]UnbondingDelegationEntry, 0, len(ubds))
+		for _, ubd := range ubds {
+			for _, e := range ubd.Entries {
+				entries = append(entries, UnbondingDelegationEntry{
+					Validator:      ubd.ValidatorAddress,
+					CreationHeight: e.CreationHeight,
+					CompletionTime: wasmvmtypes.Uint64(e.CompletionTime.UnixNano()),
+					InitialBalance: e.InitialBalance.String(),
+					Balance:        e.Balance.String(),
+				})
+			}
+		}
+		return json.Marshal(UnbondingDelegationsResponse{Entries: entries})
+	}
+}
+
 func sdkToDelegations(ctx sdk.Context, keeper types.StakingKeeper, delegations []stakingtypes.Delegation) (wasmvmtypes.Array[wasmvmtypes.Delegation], error) {
 	result := make([]wasmvmtypes.Delegation, len(delegations))
 	bondDenom, err := keeper.BondDenom(ctx)
@@ -710,6 +912,506 @@ func WasmQuerier(k wasmQueryKeeper) func(ctx sdk.Context, request *wasmvmtypes.W
 	}
 }
 
+// CodeDetailsQuery is the custom query request handled by the CodeDetailsQuerier.
+// Either the code id or a contract address must be set. With the contract address, a contract can
+// look up its own code.
+type CodeDetailsQuery struct {
+	CodeDetails *struct {
+		CodeID       uint64 `json:"code_id,omitempty"`
+		ContractAddr string `json:"contract_addr,omitempty"`
+	} `json:"code_details,omitempty"`
+}
+
+// CodeDetailsResponse is the response to a CodeDetailsQuery
+type CodeDetailsResponse struct {
+	CodeID                uint64               `json:"code_id"`
+	Creator               string               `json:"creator"`
+	Checksum              wasmvmtypes.Checksum `json:"checksum"`
+	InstantiatePermission types.AccessConfig   `json:"instantiate_permission"`
+}
+
+// CodeDetailsQuerier is a custom querier that returns the checksum, creator and instantiate permission of a code
+func CodeDetailsQuerier(k wasmQueryKeeper) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req CodeDetailsQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.CodeDetails == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		codeID := req.CodeDetails.CodeID
+		if contractAddr := req.CodeDetails.ContractAddr; contractAddr != "" {
+			addr, err := sdk.AccAddressFromBech32(contractAddr)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, contractAddr)
+			}
+			contractInfo := k.GetContractInfo(ctx, addr)
+			if contractInfo == nil {
+				return nil, types.ErrNoSuchContractFn(contractAddr).
+					Wrapf("address %s", contractAddr)
+			}
+			codeID = contractInfo.CodeID
+		}
+		if codeID == 0 {
+			return nil, types.ErrEmpty.Wrap("code id")
+		}
+		info := k.GetCodeInfo(ctx, codeID)
+		if info == nil {
+			return nil, types.ErrNoSuchCodeFn(codeID).
+				Wrapf("code id %d", codeID)
+		}
+		return json.Marshal(CodeDetailsResponse{
+			CodeID:                codeID,
+			Creator:               info.Creator,
+			Checksum:              info.CodeHash,
+			InstantiatePermission: info.InstantiateConfig,
+		})
+	}
+}
+
+// IsPinnedQuery is the custom query request handled by the IsPinnedQuerier
+type IsPinnedQuery struct {
+	IsPinned *struct {
+		CodeID uint64 `json:"code_id"`
+	} `json:"is_pinned,omitempty"`
+}
+
+// IsPinnedResponse is the response to an IsPinnedQuery
+type IsPinnedResponse struct {
+	Pinned bool `json:"pinned"`
+}
+
+// IsPinnedQuerier is a custom querier that returns if a code is pinned in the wasmvm cache.
+// Unknown code ids are reported as not pinned.
+func IsPinnedQuerier(k wasmQueryKeeper) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req IsPinnedQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.IsPinned == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		if req.IsPinned.CodeID == 0 {
+			return nil, types.ErrEmpty.Wrap("code id")
+		}
+		return json.Marshal(IsPinnedResponse{Pinned: k.IsPinnedCode(ctx, req.IsPinned.CodeID)})
+	}
+}
+
+// ContractAdminQuery is the custom query request handled by the ContractAdminQuerier
+type ContractAdminQuery struct {
+	ContractAdmin *struct {
+		ContractAddr string `json:"contract_addr"`
+	} `json:"contract_admin,omitempty"`
+}
+
+// ContractAdminResponse is the response to a ContractAdminQuery. Admin is empty when not set.
+type ContractAdminResponse struct {
+	Admin string `json:"admin"`
+}
+
+// ContractAdminQuerier is a custom querier that returns the admin of a contract only.
+// This is cheaper for contracts than a full contract info query before a migration.
+func ContractAdminQuerier(k contractMetaDataSource) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req ContractAdminQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.ContractAdmin == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		contractAddr := req.ContractAdmin.ContractAddr
+		addr, err := sdk.AccAddressFromBech32(contractAddr)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, contractAddr)
+		}
+		info := k.GetContractInfo(ctx, addr)
+		if info == nil {
+			return nil, types.ErrNoSuchContractFn(contractAddr).
+				Wrapf("address %s", contractAddr)
+		}
+		return json.Marshal(ContractAdminResponse{Admin: info.Admin})
+	}
+}
+
+// ContractExistsQuery is the custom query request handled by the ContractExistsQuerier
+type ContractExistsQuery struct {
+	ContractExists *struct {
+		Address string `json:"address"`
+	} `json:"contract_exists,omitempty"`
+}
+
+// ContractExistsResponse is the response to a ContractExistsQuery
+type ContractExistsResponse struct {
+	Exists bool `json:"exists"`
+}
+
+type contractExistenceSource interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+}
+
+// ContractExistsQuerier is a custom querier that returns if a contract exists for an address.
+// This is cheaper for contracts than a contract info query and does not fail for an unknown address.
+func ContractExistsQuerier(k contractExistenceSource) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req ContractExistsQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.ContractExists == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		addrStr := req.ContractExists.Address
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, addrStr)
+		}
+		return json.Marshal(ContractExistsResponse{Exists: k.HasContractInfo(ctx, addr)})
+	}
+}
+
+// Instantiate2AddressTakenQuery is the custom query request handled by the Instantiate2AddressTakenQuerier
+type Instantiate2AddressTakenQuery struct {
+	Instantiate2AddressTaken *struct {
+		CodeID  uint64                   `json:"code_id"`
+		Creator string                   `json:"creator"`
+		Salt    []byte                   `json:"salt"`
+		Msg     types.RawContractMessage `json:"msg,omitempty"`
+		FixMsg  bool                     `json:"fix_msg,omitempty"`
+	} `json:"instantiate2_address_taken,omitempty"`
+}
+
+// Instantiate2AddressTakenResponse is the response to an Instantiate2AddressTakenQuery
+type Instantiate2AddressTakenResponse struct {
+	Address string `json:"address"`
+	Taken   bool   `json:"taken"`
+}
+
+type instantiate2AddressSource interface {
+	contractExistenceSource
+	PredictInstantiate2Address(ctx context.Context, codeID uint64, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) (sdk.AccAddress, error)
+}
+
+// Instantiate2AddressTakenQuerier is a custom querier that returns the address an instantiate2 call would create
+// and if a contract exists at this address already. Factory contracts can use it to pick a salt without collision.
+func Instantiate2AddressTakenQuerier(k instantiate2AddressSource) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req Instantiate2AddressTakenQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.Instantiate2AddressTaken == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		q := req.Instantiate2AddressTaken
+		creator, err := sdk.AccAddressFromBech32(q.Creator)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, q.Creator)
+		}
+		if err := types.ValidateSalt(q.Salt); err != nil {
+			return nil, errorsmod.Wrap(err, "salt")
+		}
+		if q.FixMsg {
+			if err := q.Msg.ValidateBasic(); err != nil {
+				return nil, errorsmod.Wrap(err, "msg")
+			}
+		}
+		addr, err := k.PredictInstantiate2Address(ctx, q.CodeID, creator, q.Salt, q.Msg, q.FixMsg)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(Instantiate2AddressTakenResponse{
+			Address: addr.String(),
+			Taken:   k.HasContractInfo(ctx, addr),
+		})
+	}
+}
+
+// ContractLabelQuery is the custom query request handled by the ContractLabelQuerier
+type ContractLabelQuery struct {
+	ContractLabel *struct {
+		ContractAddr string `json:"contract_addr"`
+	} `json:"contract_label,omitempty"`
+}
+
+// ContractLabelResponse is the response to a ContractLabelQuery
+type ContractLabelResponse struct {
+	Label string `json:"label"`
+}
+
+// ContractLabelQuerier is a custom querier that returns the label of a contract.
+// For an unknown contract an empty label is returned, unless failOnMissing is set.
+func ContractLabelQuerier(k contractMetaDataSource, failOnMissing bool) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req ContractLabelQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.ContractLabel == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		contractAddr := req.ContractLabel.ContractAddr
+		addr, err := sdk.AccAddressFromBech32(contractAddr)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, contractAddr)
+		}
+		var res ContractLabelResponse
+		switch info := k.GetContractInfo(ctx, addr); {
+		case info != nil:
+			res.Label = info.Label
+		case failOnMissing:
+			return nil, types.ErrNoSuchContractFn(contractAddr).
+				Wrapf("address %s", contractAddr)
+		}
+		return json.Marshal(res)
+	}
+}
+
+// RawStateWithProofQuery is the custom query request handled by the RawStateWithProofQuerier
+type RawStateWithProofQuery struct {
+	RawStateWithProof *struct {
+		ContractAddr string `json:"contract_addr"`
+		Key          []byte `json:"key"`
+	} `json:"raw_state_with_proof,omitempty"`
+}
+
+// RawStateWithProofResponse is the response to a RawStateWithProofQuery.
+// The proof is a protobuf encoded merkle ProofOps against the app hash of the given height.
+// For an absent key, the value is empty and the proof is an absence proof.
+type RawStateWithProofResponse struct {
+	Value  []byte `json:"value"`
+	Proof  []byte `json:"proof"`
+	Height uint64 `json:"height"`
+}
+
+// RawStateWithProofQuerier is a custom querier that returns the raw state value of a contract key together with
+// a merkle proof. Proofs are only available for committed state so that the result reflects the last
+// committed block, not the state of the current transaction.
+// The source is usually the app's commit multistore and storeName the wasm store key name.
+func RawStateWithProofQuerier(source storetypes.Queryable, storeName string) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req RawStateWithProofQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.RawStateWithProof == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		contractAddr := req.RawStateWithProof.ContractAddr
+		addr, err := sdk.AccAddressFromBech32(contractAddr)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, contractAddr)
+		}
+		if source == nil {
+			return nil, errorsmod.Wrap(types.ErrQueryFailed, "state proofs not available")
+		}
+		res, err := source.Query(&storetypes.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", storeName),
+			Data:  append(types.GetContractStorePrefix(addr), req.RawStateWithProof.Key...),
+			Prove: true,
+		})
+		switch {
+		case err != nil:
+			return nil, errorsmod.Wrap(types.ErrQueryFailed, err.Error())
+		case res.ProofOps == nil || len(res.ProofOps.Ops) == 0:
+			return nil, errorsmod.Wrap(types.ErrQueryFailed, "state proofs not available")
+		}
+		proof, err := res.ProofOps.Marshal()
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrQueryFailed, err.Error())
+		}
+		gasCfg := storetypes.KVGasConfig()
+		ctx.GasMeter().ConsumeGas(gasCfg.ReadCostFlat+gasCfg.ReadCostPerByte*storetypes.Gas(len(res.Value)+len(proof)), "raw state with proof")
+		return json.Marshal(RawStateWithProofResponse{
+			Value:  res.Value,
+			Proof:  proof,
+			Height: uint64(res.Height),
+		})
+	}
+}
+
+// WasmParamsQuery is the custom query request handled by the WasmParamsQuerier
+type WasmParamsQuery struct {
+	WasmParams *struct{} `json:"wasm_params,omitempty"`
+}
+
+type paramsSource interface {
+	GetParams(ctx context.Context) types.Params
+}
+
+// WasmParamsQuerier is a custom querier that returns the json encoded types.Params of the wasm module
+// so that contracts can adapt to the chain configuration.
+func WasmParamsQuerier(k paramsSource) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req WasmParamsQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.WasmParams == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		return json.Marshal(k.GetParams(ctx))
+	}
+}
+
+// AccountInfoQuery is the custom query request handled by the AccountInfoQuerier
+type AccountInfoQuery struct {
+	AccountInfo *struct {
+		Address string `json:"address"`
+	} `json:"account_info,omitempty"`
+}
+
+// AccountInfoResponse is the response to an AccountInfoQuery
+type AccountInfoResponse struct {
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+}
+
+type accountSource interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// AccountInfoQuerier is a custom querier that returns the account number and sequence of an account
+// so that contracts can construct meta-transactions.
+// For an unknown account zero values are returned, unless failOnMissing is set.
+func AccountInfoQuerier(k accountSource, failOnMissing bool) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req AccountInfoQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.AccountInfo == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		accountAddr := req.AccountInfo.Address
+		addr, err := sdk.AccAddressFromBech32(accountAddr)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, accountAddr)
+		}
+		var res AccountInfoResponse
+		switch acc := k.GetAccount(ctx, addr); {
+		case acc != nil:
+			res.AccountNumber, res.Sequence = acc.GetAccountNumber(), acc.GetSequence()
+		case failOnMissing:
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s", accountAddr)
+		}
+		return json.Marshal(res)
+	}
+}
+
+type feeAllowanceSource interface {
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
+// FeeAllowanceQuery is the custom query request handled by the FeeAllowanceQuerier
+type FeeAllowanceQuery struct {
+	FeeAllowance *struct {
+		Granter string `json:"granter"`
+		Grantee string `json:"grantee"`
+	} `json:"fee_allowance,omitempty"`
+}
+
+// FeeAllowanceResponse is the response to a FeeAllowanceQuery
+type FeeAllowanceResponse struct {
+	// Allowance is the proto encoded fee allowance or nil when none was granted
+	Allowance *wasmvmtypes.AnyMsg `json:"allowance"`
+}
+
+// FeeAllowanceQuerier is a custom querier that returns the fee grant allowance between a granter and a grantee.
+// When no allowance exists, an empty response is returned.
+func FeeAllowanceQuerier(k feeAllowanceSource) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req FeeAllowanceQuery
+		if err := json.Unmarshal(request, &req); err != nil || req.FeeAllowance == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		granter, err := sdk.AccAddressFromBech32(req.FeeAllowance.Granter)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, req.FeeAllowance.Granter)
+		}
+		grantee, err := sdk.AccAddressFromBech32(req.FeeAllowance.Grantee)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, req.FeeAllowance.Grantee)
+		}
+		var res FeeAllowanceResponse
+		allowance, err := k.GetAllowance(ctx, granter, grantee)
+		switch {
+		case errors.Is(err, sdkerrors.ErrNotFound):
+		case err != nil:
+			return nil, err
+		default:
+			msg, ok := allowance.(proto.Message)
+			if !ok {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+			}
+			anyAllowance, err := codectypes.NewAnyWithValue(msg)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "allowance")
+			}
+			res.Allowance = &wasmvmtypes.AnyMsg{TypeURL: anyAllowance.TypeUrl, Value: anyAllowance.Value}
+		}
+		return json.Marshal(res)
+	}
+}
+
+// Bech32Query is the custom query request handled by the Bech32Querier
+type Bech32Query struct {
+	Bech32Encode *struct {
+		Prefix  string `json:"prefix"`
+		Address []byte `json:"address"`
+	} `json:"bech32_encode,omitempty"`
+	Bech32Decode *struct {
+		Address string `json:"address"`
+	} `json:"bech32_decode,omitempty"`
+}
+
+// Bech32EncodeResponse is the response to a bech32 encode query
+type Bech32EncodeResponse struct {
+	Address string `json:"address"`
+}
+
+// Bech32DecodeResponse is the response to a bech32 decode query
+type Bech32DecodeResponse struct {
+	Prefix  string `json:"prefix"`
+	Address []byte `json:"address"`
+}
+
+// Bech32Querier is a custom querier that converts raw address bytes to bech32 with any prefix and back.
+// This allows contracts to handle addresses of other chains without a bech32 implementation in wasm.
+func Bech32Querier() CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var req Bech32Query
+		if err := json.Unmarshal(request, &req); err != nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		switch {
+		case req.Bech32Encode != nil:
+			prefix := req.Bech32Encode.Prefix
+			if err := validateBech32Prefix(prefix); err != nil {
+				return nil, err
+			}
+			if err := validateBech32AddressLen(req.Bech32Encode.Address); err != nil {
+				return nil, err
+			}
+			addr, err := bech32.ConvertAndEncode(prefix, req.Bech32Encode.Address)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			return json.Marshal(Bech32EncodeResponse{Address: addr})
+		case req.Bech32Decode != nil:
+			prefix, bz, err := bech32.DecodeAndConvert(req.Bech32Decode.Address)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			if err := validateBech32AddressLen(bz); err != nil {
+				return nil, err
+			}
+			return json.Marshal(Bech32DecodeResponse{Prefix: prefix, Address: bz})
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+	}
+}
+
+// validateBech32Prefix ensures the prefix is a lower case human-readable part as defined in BIP-173
+func validateBech32Prefix(prefix string) error {
+	if len(prefix) == 0 {
+		return errorsmod.Wrap(types.ErrEmpty, "prefix")
+	}
+	if len(prefix) > 83 {
+		return errorsmod.Wrapf(types.ErrLimit, "prefix length: %d > 83", len(prefix))
+	}
+	for _, c := range prefix {
+		if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+			return errorsmod.Wrapf(types.ErrInvalid, "prefix character %q", c)
+		}
+	}
+	return nil
+}
+
+func validateBech32AddressLen(bz []byte) error {
+	if len(bz) == 0 || len(bz) > address.MaxAddrLen {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "address length: %d", len(bz))
+	}
+	return nil
+}
+
 func DistributionQuerier(k types.DistributionKeeper) func(ctx sdk.Context, request *wasmvmtypes.DistributionQuery) ([]byte, error) {
 	return func(ctx sdk.Context, req *wasmvmtypes.DistributionQuery) ([]byte, error) {
 		switch {
@@ -1,18 +1,25 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -23,13 +30,18 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
@@ -416,6 +428,301 @@ func TestBankQuerierAllMetadataPagination(t *testing.T) {
 	assert.Equal(t, exp, capturedPagination)
 }
 
+func TestTotalSupplyQuerier(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities)
+	keepers.Faucet.Mint(ctx, keeper.RandomAccountAddress(t), sdk.NewInt64Coin("alx", 1), sdk.NewInt64Coin("blx", 2))
+	q := keeper.TotalSupplyQuerier(keepers.BankKeeper, 1)
+
+	specs := map[string]struct {
+		req    string
+		expRes keeper.TotalSupplyResponse
+	}{
+		"single denom": {
+			req: `{"total_supply":{"denom":"blx"}}`,
+			expRes: keeper.TotalSupplyResponse{
+				Supply: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(2, "blx")},
+			},
+		},
+		"unknown denom": {
+			req: `{"total_supply":{"denom":"unknown"}}`,
+			expRes: keeper.TotalSupplyResponse{
+				Supply: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(0, "unknown")},
+			},
+		},
+		"all denoms capped": {
+			req: `{"total_supply":{}}`,
+			expRes: keeper.TotalSupplyResponse{
+				Supply:  wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1, "alx")},
+				NextKey: []byte("blx"),
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			require.NoError(t, gotErr)
+			var gotRes keeper.TotalSupplyResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+	// and unsupported query
+	_, gotErr := q(ctx, []byte(`{"foo":{}}`))
+	assert.Equal(t, wasmvmtypes.UnsupportedRequest{Kind: "custom"}, gotErr)
+}
+
+func TestConnectionsQuerier(t *testing.T) {
+	var ctx sdk.Context
+	allConnections := []*connectiontypes.IdentifiedConnection{
+		{Id: "connection-0", ClientId: "07-tendermint-0", State: connectiontypes.OPEN},
+		{Id: "connection-1", ClientId: "07-tendermint-1", State: connectiontypes.INIT},
+		{Id: "connection-2", ClientId: "07-tendermint-1", State: connectiontypes.OPEN},
+		{Id: "connection-3", ClientId: "07-tendermint-2", State: connectiontypes.OPEN},
+	}
+	// mock paginates by the index of the connection in the list
+	mock := connectionSourceFn(func(ctx context.Context, req *connectiontypes.QueryConnectionsRequest) (*connectiontypes.QueryConnectionsResponse, error) {
+		var start uint64
+		if len(req.Pagination.Key) != 0 {
+			start = sdk.BigEndianToUint64(req.Pagination.Key)
+		}
+		end := min(start+req.Pagination.Limit, uint64(len(allConnections)))
+		res := &connectiontypes.QueryConnectionsResponse{Connections: allConnections[start:end], Pagination: &query.PageResponse{}}
+		if end < uint64(len(allConnections)) {
+			res.Pagination.NextKey = sdk.Uint64ToBigEndian(end)
+		}
+		return res, nil
+	})
+	q := keeper.ConnectionsQuerier(mock, 2)
+
+	specs := map[string]struct {
+		req    string
+		expRes keeper.ConnectionsResponse
+	}{
+		"first page": {
+			req: `{"connections":{}}`,
+			expRes: keeper.ConnectionsResponse{
+				Connections: []keeper.IBCConnection{{ConnectionID: "connection-0", ClientID: "07-tendermint-0"}},
+				NextKey:     sdk.Uint64ToBigEndian(2),
+			},
+		},
+		"last page": {
+			req: fmt.Sprintf(`{"connections":{"key":%q}}`, base64.StdEncoding.EncodeToString(sdk.Uint64ToBigEndian(2))),
+			expRes: keeper.ConnectionsResponse{
+				Connections: []keeper.IBCConnection{
+					{ConnectionID: "connection-2", ClientID: "07-tendermint-1"},
+					{ConnectionID: "connection-3", ClientID: "07-tendermint-2"},
+				},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			require.NoError(t, gotErr)
+			var gotRes keeper.ConnectionsResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+	// and unsupported query
+	_, gotErr := q(ctx, []byte(`{"foo":{}}`))
+	assert.Equal(t, wasmvmtypes.UnsupportedRequest{Kind: "custom"}, gotErr)
+}
+
+type connectionSourceFn func(ctx context.Context, req *connectiontypes.QueryConnectionsRequest) (*connectiontypes.QueryConnectionsResponse, error)
+
+func (f connectionSourceFn) Connections(ctx context.Context, req *connectiontypes.QueryConnectionsRequest) (*connectiontypes.QueryConnectionsResponse, error) {
+	return f(ctx, req)
+}
+
+func TestValidatorInfoQuerier(t *testing.T) {
+	var ctx sdk.Context
+	valAddr := make(sdk.ValAddress, types.SDKAddrLen)
+	valAddr[0] = 12
+	validator := func(status stakingtypes.BondStatus) stakingtypes.Validator {
+		return stakingtypes.Validator{
+			OperatorAddress: valAddr.String(),
+			Status:          status,
+			Tokens:          sdkmath.NewInt(1000),
+			Commission:      stakingtypes.NewCommission(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec()),
+		}
+	}
+	specs := map[string]struct {
+		req    string
+		mock   validatorSourceFn
+		expRes keeper.ValidatorInfoResponse
+		expErr error
+	}{
+		"bonded validator": {
+			req: fmt.Sprintf(`{"validator_info":{"address":%q}}`, valAddr.String()),
+			mock: func(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+				return validator(stakingtypes.Bonded), nil
+			},
+			expRes: keeper.ValidatorInfoResponse{
+				Address:    valAddr.String(),
+				Commission: "0.100000000000000000",
+				Status:     "BOND_STATUS_BONDED",
+				Tokens:     "1000",
+			},
+		},
+		"unbonding validator": {
+			req: fmt.Sprintf(`{"validator_info":{"address":%q}}`, valAddr.String()),
+			mock: func(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+				return validator(stakingtypes.Unbonding), nil
+			},
+			expRes: keeper.ValidatorInfoResponse{
+				Address:    valAddr.String(),
+				Commission: "0.100000000000000000",
+				Status:     "BOND_STATUS_UNBONDING",
+				Tokens:     "1000",
+			},
+		},
+		"unknown validator": {
+			req: fmt.Sprintf(`{"validator_info":{"address":%q}}`, valAddr.String()),
+			mock: func(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+				return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+			},
+			expErr: stakingtypes.ErrNoValidatorFound,
+		},
+		"invalid address": {
+			req:    `{"validator_info":{"address":"not a valid addr"}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.ValidatorInfoQuerier(spec.mock)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.ValidatorInfoResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
+func TestConvertSdkCoinsToWasmCoinsRoundTrip(t *testing.T) {
+	specs := map[string]sdk.Coins{
+		"empty":       nil,
+		"single coin": sdk.NewCoins(sdk.NewInt64Coin("alx", 1)),
+		"multiple coins": sdk.NewCoins(
+			sdk.NewInt64Coin("alx", 1),
+			sdk.NewCoin("blx", sdkmath.NewIntFromUint64(math.MaxUint64).MulRaw(10)),
+			sdk.NewInt64Coin("ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", 3),
+		),
+	}
+	for name, src := range specs {
+		t.Run(name, func(t *testing.T) {
+			wasmCoins := keeper.ConvertSdkCoinsToWasmCoins(src)
+			require.Len(t, wasmCoins, len(src))
+			got, err := keeper.ConvertWasmCoinsToSdkCoins(wasmCoins)
+			require.NoError(t, err)
+			assert.True(t, src.Equal(got), "exp %s but got %s", src, got)
+
+			for i, c := range src {
+				gotCoin, err := keeper.ConvertWasmCoinToSdkCoin(keeper.ConvertSdkCoinToWasmCoin(c))
+				require.NoError(t, err)
+				assert.Equal(t, src[i], gotCoin)
+			}
+		})
+	}
+}
+
+func TestUnbondingDelegationsQuerier(t *testing.T) {
+	var ctx sdk.Context
+	delAddr := keeper.RandomAccountAddress(t)
+	valAddr1, valAddr2 := make(sdk.ValAddress, types.SDKAddrLen), make(sdk.ValAddress, types.SDKAddrLen)
+	valAddr1[0], valAddr2[0] = 1, 2
+	myTime := time.Unix(1700000000, 0).UTC()
+	specs := map[string]struct {
+		req    string
+		mock   unbondingDelegationSourceFn
+		expRes keeper.UnbondingDelegationsResponse
+		expErr error
+	}{
+		"no unbonding delegations": {
+			req: fmt.Sprintf(`{"unbonding_delegations":{"delegator":%q}}`, delAddr.String()),
+			mock: func(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.UnbondingDelegation, error) {
+				return nil, nil
+			},
+			expRes: keeper.UnbondingDelegationsResponse{Entries: []keeper.UnbondingDelegationEntry{}},
+		},
+		"multiple entries": {
+			req: fmt.Sprintf(`{"unbonding_delegations":{"delegator":%q}}`, delAddr.String()),
+			mock: func(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.UnbondingDelegation, error) {
+				entry := func(height int64, completion time.Time, balance int64) stakingtypes.UnbondingDelegationEntry {
+					return stakingtypes.NewUnbondingDelegationEntry(height, completion, sdkmath.NewInt(balance), uint64(height))
+				}
+				ubd1 := stakingtypes.UnbondingDelegation{
+					DelegatorAddress: delAddr.String(),
+					ValidatorAddress: valAddr1.String(),
+					Entries:          []stakingtypes.UnbondingDelegationEntry{entry(10, myTime, 100), entry(11, myTime.Add(time.Hour), 200)},
+				}
+				ubd2 := stakingtypes.UnbondingDelegation{
+					DelegatorAddress: delAddr.String(),
+					ValidatorAddress: valAddr2.String(),
+					Entries:          []stakingtypes.UnbondingDelegationEntry{entry(12, myTime, 300)},
+				}
+				return []stakingtypes.UnbondingDelegation{ubd1, ubd2}, nil
+			},
+			expRes: keeper.UnbondingDelegationsResponse{Entries: []keeper.UnbondingDelegationEntry{
+				{Validator: valAddr1.String(), CreationHeight: 10, CompletionTime: wasmvmtypes.Uint64(myTime.UnixNano()), InitialBalance: "100", Balance: "100"},
+				{Validator: valAddr1.String(), CreationHeight: 11, CompletionTime: wasmvmtypes.Uint64(myTime.Add(time.Hour).UnixNano()), InitialBalance: "200", Balance: "200"},
+				{Validator: valAddr2.String(), CreationHeight: 12, CompletionTime: wasmvmtypes.Uint64(myTime.UnixNano()), InitialBalance: "300", Balance: "300"},
+			}},
+		},
+		"invalid address": {
+			req:    `{"unbonding_delegations":{"delegator":"not a valid addr"}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotMaxRetrieve uint16
+			mock := func(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.UnbondingDelegation, error) {
+				gotMaxRetrieve = maxRetrieve
+				assert.Equal(t, delAddr, delegator)
+				return spec.mock(ctx, delegator, maxRetrieve)
+			}
+			q := keeper.UnbondingDelegationsQuerier(unbondingDelegationSourceFn(mock), 5)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, uint16(5), gotMaxRetrieve)
+			var gotRes keeper.UnbondingDelegationsResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
+type unbondingDelegationSourceFn func(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.UnbondingDelegation, error)
+
+func (f unbondingDelegationSourceFn) GetUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.UnbondingDelegation, error) {
+	return f(ctx, delegator, maxRetrieve)
+}
+
+type validatorSourceFn func(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+
+func (f validatorSourceFn) GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	return f(ctx, addr)
+}
+
 func TestContractInfoWasmQuerier(t *testing.T) {
 	myValidContractAddr := keeper.RandomBech32AccountAddress(t)
 	myCreatorAddr := keeper.RandomBech32AccountAddress(t)
@@ -520,6 +827,573 @@ func TestContractInfoWasmQuerier(t *testing.T) {
 	}
 }
 
+func TestContractAdminQuerier(t *testing.T) {
+	myValidContractAddr := keeper.RandomBech32AccountAddress(t)
+	myAdminAddr := keeper.RandomBech32AccountAddress(t)
+	var ctx sdk.Context
+
+	specs := map[string]struct {
+		req    string
+		mock   mockWasmQueryKeeper
+		expRes keeper.ContractAdminResponse
+		expErr error
+	}{
+		"with admin": {
+			req: fmt.Sprintf(`{"contract_admin":{"contract_addr":%q}}`, myValidContractAddr),
+			mock: mockWasmQueryKeeper{GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+				val := types.ContractInfoFixture(func(i *types.ContractInfo) {
+					i.Admin = myAdminAddr
+				})
+				return &val
+			}},
+			expRes: keeper.ContractAdminResponse{Admin: myAdminAddr},
+		},
+		"without admin": {
+			req: fmt.Sprintf(`{"contract_admin":{"contract_addr":%q}}`, myValidContractAddr),
+			mock: mockWasmQueryKeeper{GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+				val := types.ContractInfoFixture()
+				return &val
+			}},
+			expRes: keeper.ContractAdminResponse{},
+		},
+		"unknown contract": {
+			req: fmt.Sprintf(`{"contract_admin":{"contract_addr":%q}}`, myValidContractAddr),
+			mock: mockWasmQueryKeeper{GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+				return nil
+			}},
+			expErr: types.ErrNoSuchContractFn(myValidContractAddr),
+		},
+		"invalid addr": {
+			req:    `{"contract_admin":{"contract_addr":"not a valid addr"}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.ContractAdminQuerier(spec.mock)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.ContractAdminResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
+func TestRawStateWithProofQuerier(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	contractAddr := keeper.RandomAccountAddress(t)
+	ms.GetKVStore(storeKey).Set(append(types.GetContractStorePrefix(contractAddr), []byte("foo")...), []byte("bar"))
+	cid := ms.Commit()
+	ctx := sdk.NewContext(ms, cmtproto.Header{}, false, log.NewTestLogger(t)).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	keyPath := func(key string) string {
+		return merkle.KeyPath{}.
+			AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+			AppendKey(append(types.GetContractStorePrefix(contractAddr), []byte(key)...), merkle.KeyEncodingHex).
+			String()
+	}
+	query := func(key string) json.RawMessage {
+		return []byte(fmt.Sprintf(`{"raw_state_with_proof":{"contract_addr":%q,"key":%q}}`, contractAddr.String(), base64.StdEncoding.EncodeToString([]byte(key))))
+	}
+	prt := rootmulti.DefaultProofRuntime()
+
+	specs := map[string]struct {
+		source   storetypes.Queryable
+		req      json.RawMessage
+		expValue []byte
+		expErr   error
+	}{
+		"present key": {
+			source:   ms.(storetypes.Queryable),
+			req:      query("foo"),
+			expValue: []byte("bar"),
+		},
+		"absent key": {
+			source: ms.(storetypes.Queryable),
+			req:    query("other"),
+		},
+		"proofs not available": {
+			req:    query("foo"),
+			expErr: types.ErrQueryFailed,
+		},
+		"invalid address": {
+			source: ms.(storetypes.Queryable),
+			req:    []byte(`{"raw_state_with_proof":{"contract_addr":"invalid","key":""}}`),
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unknown query": {
+			source: ms.(storetypes.Queryable),
+			req:    []byte(`{"other":{}}`),
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.RawStateWithProofQuerier(spec.source, types.StoreKey)
+			// when
+			gotBz, gotErr := q(ctx, spec.req)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var got keeper.RawStateWithProofResponse
+			require.NoError(t, json.Unmarshal(gotBz, &got))
+			assert.Equal(t, spec.expValue, got.Value)
+			assert.Equal(t, uint64(cid.Version), got.Height)
+			var proof cmtcrypto.ProofOps
+			require.NoError(t, proof.Unmarshal(got.Proof))
+			if spec.expValue != nil {
+				assert.NoError(t, prt.VerifyValue(&proof, cid.Hash, keyPath("foo"), spec.expValue))
+				return
+			}
+			assert.NoError(t, prt.VerifyAbsence(&proof, cid.Hash, keyPath("other")))
+		})
+	}
+}
+
+func TestWasmParamsQuerier(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := types.Params{
+		CodeUploadAccess:             types.AccessTypeAnyOfAddresses.With(keeper.RandomAccountAddress(t)),
+		InstantiateDefaultPermission: types.AccessTypeNobody,
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	q := keeper.WasmParamsQuerier(k)
+
+	// when
+	gotBz, gotErr := q(ctx, []byte(`{"wasm_params":{}}`))
+
+	// then
+	require.NoError(t, gotErr)
+	var gotParams types.Params
+	require.NoError(t, json.Unmarshal(gotBz, &gotParams))
+	assert.Equal(t, k.GetParams(ctx), gotParams)
+	assert.Equal(t, params, gotParams)
+
+	// and
+	_, gotErr = q(ctx, []byte(`{"foo":{}}`))
+	assert.ErrorIs(t, gotErr, wasmvmtypes.UnsupportedRequest{Kind: "custom"})
+}
+
+func TestAccountInfoQuerier(t *testing.T) {
+	parentCtx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities)
+	myAddr := keeper.RandomAccountAddress(t)
+	acc := keepers.AccountKeeper.NewAccountWithAddress(parentCtx, myAddr)
+	require.NoError(t, acc.SetSequence(3))
+	keepers.AccountKeeper.SetAccount(parentCtx, acc)
+	myUnknownAddr := keeper.RandomBech32AccountAddress(t)
+
+	specs := map[string]struct {
+		req           string
+		failOnMissing bool
+		expRes        keeper.AccountInfoResponse
+		expErr        error
+	}{
+		"existing account": {
+			req:    fmt.Sprintf(`{"account_info":{"address":%q}}`, myAddr.String()),
+			expRes: keeper.AccountInfoResponse{AccountNumber: acc.GetAccountNumber(), Sequence: 3},
+		},
+		"existing account - fail on missing": {
+			req:           fmt.Sprintf(`{"account_info":{"address":%q}}`, myAddr.String()),
+			failOnMissing: true,
+			expRes:        keeper.AccountInfoResponse{AccountNumber: acc.GetAccountNumber(), Sequence: 3},
+		},
+		"unknown account": {
+			req:    fmt.Sprintf(`{"account_info":{"address":%q}}`, myUnknownAddr),
+			expRes: keeper.AccountInfoResponse{},
+		},
+		"unknown account - fail on missing": {
+			req:           fmt.Sprintf(`{"account_info":{"address":%q}}`, myUnknownAddr),
+			failOnMissing: true,
+			expErr:        sdkerrors.ErrUnknownAddress,
+		},
+		"invalid addr": {
+			req:    `{"account_info":{"address":"not a valid addr"}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			q := keeper.AccountInfoQuerier(keepers.AccountKeeper, spec.failOnMissing)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.AccountInfoResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
+func TestFeeAllowanceQuerier(t *testing.T) {
+	var ctx sdk.Context
+	granter, grantee := keeper.RandomAccountAddress(t), keeper.RandomAccountAddress(t)
+	myAllowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+	myAllowanceBz, err := proto.Marshal(myAllowance)
+	require.NoError(t, err)
+	mock := feeAllowanceSourceFn(func(ctx context.Context, gotGranter, gotGrantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+		if !gotGranter.Equals(granter) || !gotGrantee.Equals(grantee) {
+			return nil, sdkerrors.ErrNotFound.Wrap("fee-grant not found")
+		}
+		return myAllowance, nil
+	})
+	q := keeper.FeeAllowanceQuerier(mock)
+
+	specs := map[string]struct {
+		req    string
+		expRes keeper.FeeAllowanceResponse
+		expErr error
+	}{
+		"existing allowance": {
+			req: fmt.Sprintf(`{"fee_allowance":{"granter":%q,"grantee":%q}}`, granter.String(), grantee.String()),
+			expRes: keeper.FeeAllowanceResponse{
+				Allowance: &wasmvmtypes.AnyMsg{TypeURL: "/cosmos.feegrant.v1beta1.BasicAllowance", Value: myAllowanceBz},
+			},
+		},
+		"missing allowance": {
+			req:    fmt.Sprintf(`{"fee_allowance":{"granter":%q,"grantee":%q}}`, grantee.String(), granter.String()),
+			expRes: keeper.FeeAllowanceResponse{},
+		},
+		"invalid granter": {
+			req:    fmt.Sprintf(`{"fee_allowance":{"granter":"not a valid addr","grantee":%q}}`, grantee.String()),
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"invalid grantee": {
+			req:    fmt.Sprintf(`{"fee_allowance":{"granter":%q,"grantee":"not a valid addr"}}`, granter.String()),
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.FeeAllowanceResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
+type feeAllowanceSourceFn func(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+
+func (f feeAllowanceSourceFn) GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	return f(ctx, granter, grantee)
+}
+
+func TestBech32Querier(t *testing.T) {
+	var ctx sdk.Context
+	q := keeper.Bech32Querier()
+	rawAddr := bytes.Repeat([]byte{1}, 20)
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", rawAddr)
+	require.NoError(t, err)
+	// replace the last checksum character
+	invalidChecksumAddr := osmoAddr[:len(osmoAddr)-1] + "q"
+	if strings.HasSuffix(osmoAddr, "q") {
+		invalidChecksumAddr = osmoAddr[:len(osmoAddr)-1] + "p"
+	}
+
+	specs := map[string]struct {
+		req    string
+		expRes any
+		expErr error
+	}{
+		"encode": {
+			req:    fmt.Sprintf(`{"bech32_encode":{"prefix":"osmo","address":%q}}`, base64.StdEncoding.EncodeToString(rawAddr)),
+			expRes: keeper.Bech32EncodeResponse{Address: osmoAddr},
+		},
+		"encode - empty prefix": {
+			req:    fmt.Sprintf(`{"bech32_encode":{"prefix":"","address":%q}}`, base64.StdEncoding.EncodeToString(rawAddr)),
+			expErr: types.ErrEmpty,
+		},
+		"encode - upper case prefix": {
+			req:    fmt.Sprintf(`{"bech32_encode":{"prefix":"OSMO","address":%q}}`, base64.StdEncoding.EncodeToString(rawAddr)),
+			expErr: types.ErrInvalid,
+		},
+		"encode - prefix too long": {
+			req:    fmt.Sprintf(`{"bech32_encode":{"prefix":%q,"address":%q}}`, strings.Repeat("a", 84), base64.StdEncoding.EncodeToString(rawAddr)),
+			expErr: types.ErrLimit,
+		},
+		"encode - empty address": {
+			req:    `{"bech32_encode":{"prefix":"osmo","address":""}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"encode - address too long": {
+			req:    fmt.Sprintf(`{"bech32_encode":{"prefix":"osmo","address":%q}}`, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 256))),
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"decode": {
+			req:    fmt.Sprintf(`{"bech32_decode":{"address":%q}}`, osmoAddr),
+			expRes: keeper.Bech32DecodeResponse{Prefix: "osmo", Address: rawAddr},
+		},
+		"decode - invalid checksum": {
+			req:    fmt.Sprintf(`{"bech32_decode":{"address":%q}}`, invalidChecksumAddr),
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"decode - not bech32": {
+			req:    `{"bech32_decode":{"address":"not a valid addr"}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expBz, err := json.Marshal(spec.expRes)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expBz), string(gotBz))
+		})
+	}
+}
+
+func TestBech32QuerierRoundTrip(t *testing.T) {
+	var ctx sdk.Context
+	q := keeper.Bech32Querier()
+	for _, rawAddr := range [][]byte{keeper.RandomAccountAddress(t), bytes.Repeat([]byte{0xff}, 32), {0}} {
+		encReq := fmt.Sprintf(`{"bech32_encode":{"prefix":"cosmos","address":%q}}`, base64.StdEncoding.EncodeToString(rawAddr))
+		gotBz, err := q(ctx, []byte(encReq))
+		require.NoError(t, err)
+		var encRes keeper.Bech32EncodeResponse
+		require.NoError(t, json.Unmarshal(gotBz, &encRes))
+
+		decReq := fmt.Sprintf(`{"bech32_decode":{"address":%q}}`, encRes.Address)
+		gotBz, err = q(ctx, []byte(decReq))
+		require.NoError(t, err)
+		var decRes keeper.Bech32DecodeResponse
+		require.NoError(t, json.Unmarshal(gotBz, &decRes))
+		assert.Equal(t, "cosmos", decRes.Prefix)
+		assert.Equal(t, rawAddr, decRes.Address)
+	}
+}
+
+func TestContractExistsQuerier(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities)
+	example := keeper.InstantiateHackatomExampleContract(t, ctx, keepers)
+	myAccountAddr := keeper.RandomAccountAddress(t)
+	keepers.Faucet.Fund(ctx, myAccountAddr, sdk.NewInt64Coin("denom", 100))
+
+	specs := map[string]struct {
+		req    string
+		expRes keeper.ContractExistsResponse
+		expErr error
+	}{
+		"existing contract": {
+			req:    fmt.Sprintf(`{"contract_exists":{"address":%q}}`, example.Contract.String()),
+			expRes: keeper.ContractExistsResponse{Exists: true},
+		},
+		"plain account": {
+			req:    fmt.Sprintf(`{"contract_exists":{"address":%q}}`, myAccountAddr.String()),
+			expRes: keeper.ContractExistsResponse{Exists: false},
+		},
+		"nonexistent address": {
+			req:    fmt.Sprintf(`{"contract_exists":{"address":%q}}`, keeper.RandomBech32AccountAddress(t)),
+			expRes: keeper.ContractExistsResponse{Exists: false},
+		},
+		"invalid addr": {
+			req:    `{"contract_exists":{"address":"not a valid addr"}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.ContractExistsQuerier(keepers.WasmKeeper)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.ContractExistsResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
+func TestInstantiate2AddressTakenQuerier(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities)
+	example := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	initMsg, err := json.Marshal(keeper.HackatomExampleInitMsg{
+		Verifier:    keeper.RandomAccountAddress(t),
+		Beneficiary: keeper.RandomAccountAddress(t),
+	})
+	require.NoError(t, err)
+	takenSalt := []byte("taken")
+	takenAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", nil, takenSalt, false)
+	require.NoError(t, err)
+	freeSalt := []byte("free")
+	freeAddr, err := keepers.WasmKeeper.PredictInstantiate2Address(ctx, example.CodeID, example.CreatorAddr, freeSalt, nil, false)
+	require.NoError(t, err)
+	fixedMsgAddr, err := keepers.WasmKeeper.PredictInstantiate2Address(ctx, example.CodeID, example.CreatorAddr, takenSalt, initMsg, true)
+	require.NoError(t, err)
+
+	queryFn := func(codeID uint64, creator string, salt []byte) string {
+		return fmt.Sprintf(`{"instantiate2_address_taken":{"code_id":%d,"creator":%q,"salt":%q}}`, codeID, creator, base64.StdEncoding.EncodeToString(salt))
+	}
+	specs := map[string]struct {
+		req    string
+		expRes keeper.Instantiate2AddressTakenResponse
+		expErr error
+	}{
+		"free salt": {
+			req:    queryFn(example.CodeID, example.CreatorAddr.String(), freeSalt),
+			expRes: keeper.Instantiate2AddressTakenResponse{Address: freeAddr.String(), Taken: false},
+		},
+		"colliding salt": {
+			req:    queryFn(example.CodeID, example.CreatorAddr.String(), takenSalt),
+			expRes: keeper.Instantiate2AddressTakenResponse{Address: takenAddr.String(), Taken: true},
+		},
+		"colliding salt with fixed msg": {
+			req: fmt.Sprintf(`{"instantiate2_address_taken":{"code_id":%d,"creator":%q,"salt":%q,"msg":%s,"fix_msg":true}}`,
+				example.CodeID, example.CreatorAddr.String(), base64.StdEncoding.EncodeToString(takenSalt), initMsg),
+			expRes: keeper.Instantiate2AddressTakenResponse{Address: fixedMsgAddr.String(), Taken: false},
+		},
+		"unknown code": {
+			req:    queryFn(example.CodeID+1, example.CreatorAddr.String(), freeSalt),
+			expErr: types.ErrNoSuchCodeFn(example.CodeID + 1),
+		},
+		"empty salt": {
+			req:    queryFn(example.CodeID, example.CreatorAddr.String(), nil),
+			expErr: types.ErrEmpty,
+		},
+		"fixed msg without msg": {
+			req: fmt.Sprintf(`{"instantiate2_address_taken":{"code_id":%d,"creator":%q,"salt":%q,"fix_msg":true}}`,
+				example.CodeID, example.CreatorAddr.String(), base64.StdEncoding.EncodeToString(freeSalt)),
+			expErr: types.ErrInvalid,
+		},
+		"invalid creator": {
+			req:    queryFn(example.CodeID, "not a valid addr", freeSalt),
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.Instantiate2AddressTakenQuerier(keepers.WasmKeeper)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.Instantiate2AddressTakenResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
+func TestContractLabelQuerier(t *testing.T) {
+	myValidContractAddr := keeper.RandomBech32AccountAddress(t)
+	var ctx sdk.Context
+	labeled := mockWasmQueryKeeper{GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+		val := types.ContractInfoFixture(func(i *types.ContractInfo) {
+			i.Label = "my label"
+		})
+		return &val
+	}}
+	missing := mockWasmQueryKeeper{GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+		return nil
+	}}
+
+	specs := map[string]struct {
+		req           string
+		mock          mockWasmQueryKeeper
+		failOnMissing bool
+		expRes        keeper.ContractLabelResponse
+		expErr        error
+	}{
+		"labeled contract": {
+			req:    fmt.Sprintf(`{"contract_label":{"contract_addr":%q}}`, myValidContractAddr),
+			mock:   labeled,
+			expRes: keeper.ContractLabelResponse{Label: "my label"},
+		},
+		"labeled contract - fail on missing": {
+			req:           fmt.Sprintf(`{"contract_label":{"contract_addr":%q}}`, myValidContractAddr),
+			mock:          labeled,
+			failOnMissing: true,
+			expRes:        keeper.ContractLabelResponse{Label: "my label"},
+		},
+		"unknown contract": {
+			req:    fmt.Sprintf(`{"contract_label":{"contract_addr":%q}}`, myValidContractAddr),
+			mock:   missing,
+			expRes: keeper.ContractLabelResponse{},
+		},
+		"unknown contract - fail on missing": {
+			req:           fmt.Sprintf(`{"contract_label":{"contract_addr":%q}}`, myValidContractAddr),
+			mock:          missing,
+			failOnMissing: true,
+			expErr:        types.ErrNoSuchContractFn(myValidContractAddr),
+		},
+		"invalid addr": {
+			req:    `{"contract_label":{"contract_addr":"not a valid addr"}}`,
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.ContractLabelQuerier(spec.mock, spec.failOnMissing)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.ContractLabelResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
 func TestCodeInfoWasmQuerier(t *testing.T) {
 	myCreatorAddr := keeper.RandomBech32AccountAddress(t)
 	var ctx sdk.Context
@@ -587,6 +1461,126 @@ func TestCodeInfoWasmQuerier(t *testing.T) {
 	}
 }
 
+func TestIsPinnedQuerier(t *testing.T) {
+	var ctx sdk.Context
+	mock := mockWasmQueryKeeper{IsPinnedCodeFn: func(ctx context.Context, codeID uint64) bool {
+		return codeID == 1
+	}}
+	specs := map[string]struct {
+		req    string
+		expRes keeper.IsPinnedResponse
+		expErr error
+	}{
+		"pinned": {
+			req:    `{"is_pinned":{"code_id":1}}`,
+			expRes: keeper.IsPinnedResponse{Pinned: true},
+		},
+		"not pinned": {
+			req:    `{"is_pinned":{"code_id":2}}`,
+			expRes: keeper.IsPinnedResponse{Pinned: false},
+		},
+		"empty code id": {
+			req:    `{"is_pinned":{}}`,
+			expErr: types.ErrEmpty,
+		},
+		"unsupported query": {
+			req:    `{"foo":{}}`,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "custom"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.IsPinnedQuerier(mock)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.IsPinnedResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
+func TestCodeDetailsQuerier(t *testing.T) {
+	myCreatorAddr := keeper.RandomBech32AccountAddress(t)
+	myContractAddr := keeper.RandomBech32AccountAddress(t)
+	myRawChecksum := []byte("myHash78901234567890123456789012")
+	var ctx sdk.Context
+
+	codeInfoFn := func(ctx context.Context, codeID uint64) *types.CodeInfo {
+		if codeID != 1 {
+			return nil
+		}
+		return &types.CodeInfo{
+			CodeHash:          myRawChecksum,
+			Creator:           myCreatorAddr,
+			InstantiateConfig: types.AllowNobody,
+		}
+	}
+	expRes := keeper.CodeDetailsResponse{
+		CodeID:                1,
+		Creator:               myCreatorAddr,
+		Checksum:              myRawChecksum,
+		InstantiatePermission: types.AllowNobody,
+	}
+	specs := map[string]struct {
+		req    string
+		mock   mockWasmQueryKeeper
+		expRes keeper.CodeDetailsResponse
+		expErr error
+	}{
+		"by code id": {
+			req:    `{"code_details":{"code_id":1}}`,
+			mock:   mockWasmQueryKeeper{GetCodeInfoFn: codeInfoFn},
+			expRes: expRes,
+		},
+		"by contract address": {
+			req: fmt.Sprintf(`{"code_details":{"contract_addr":%q}}`, myContractAddr),
+			mock: mockWasmQueryKeeper{
+				GetCodeInfoFn: codeInfoFn,
+				GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+					val := types.ContractInfoFixture()
+					return &val
+				},
+			},
+			expRes: expRes,
+		},
+		"unknown code id": {
+			req:    `{"code_details":{"code_id":2}}`,
+			mock:   mockWasmQueryKeeper{GetCodeInfoFn: codeInfoFn},
+			expErr: types.ErrNoSuchCodeFn(2),
+		},
+		"empty code id": {
+			req:    `{"code_details":{}}`,
+			expErr: types.ErrEmpty,
+		},
+		"unknown contract": {
+			req: fmt.Sprintf(`{"code_details":{"contract_addr":%q}}`, myContractAddr),
+			mock: mockWasmQueryKeeper{GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+				return nil
+			}},
+			expErr: types.ErrNoSuchContractFn(myContractAddr),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.CodeDetailsQuerier(spec.mock)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes keeper.CodeDetailsResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes), string(gotBz))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
 func TestQueryErrors(t *testing.T) {
 	specs := map[string]struct {
 		src    error
@@ -776,6 +1770,56 @@ func (m mockedQueryRouter) Route(_ string) baseapp.GRPCQueryHandler {
 	}
 }
 
+func TestAcceptListStargateQuerier(t *testing.T) {
+	_, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities)
+	cdc := keepers.EncodingConfig.Codec
+	router := mockedQueryRouter{codec: cdc}
+	grpcData, err := cdc.Marshal(&banktypes.QueryBalanceRequest{Address: keeper.RandomBech32AccountAddress(t), Denom: "alx"})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		acceptList keeper.AcceptedQueries
+		path       string
+		expErr     error
+	}{
+		"allowed path": {
+			acceptList: keeper.AcceptedQueries{
+				"/bank.Balance": func() proto.Message { return &banktypes.QueryBalanceResponse{} },
+			},
+			path: "/bank.Balance",
+		},
+		"disallowed path": {
+			acceptList: keeper.AcceptedQueries{
+				"/bank.Balance": func() proto.Message { return &banktypes.QueryBalanceResponse{} },
+			},
+			path:   "/bank.AllBalances",
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "'/bank.AllBalances' path is not allowed from the contract"},
+		},
+		"empty accept list denies all": {
+			acceptList: keeper.AcceptedQueries{},
+			path:       "/bank.Balance",
+			expErr:     wasmvmtypes.UnsupportedRequest{Kind: "'/bank.Balance' path is not allowed from the contract"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			querier := keeper.AcceptListStargateQuerier(spec.acceptList, router, cdc)
+			gotBz, gotErr := querier(sdk.Context{}, &wasmvmtypes.StargateQuery{Path: spec.path, Data: grpcData})
+			if spec.expErr != nil {
+				assert.Equal(t, spec.expErr, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes banktypes.QueryBalanceResponse
+			require.NoError(t, cdc.UnmarshalJSON(gotBz, &gotRes))
+			assert.Equal(t, sdk.NewInt64Coin("alx", 1), *gotRes.Balance)
+		})
+	}
+	// and the default rejects all
+	_, gotErr := keeper.RejectStargateQuerier(sdk.Context{}, &wasmvmtypes.StargateQuery{Path: "/bank.Balance", Data: grpcData})
+	assert.Equal(t, wasmvmtypes.UnsupportedRequest{Kind: "Stargate queries are disabled on this chain"}, gotErr)
+}
+
 func TestGRPCQuerier(t *testing.T) {
 	const (
 		denom1 = "denom1"
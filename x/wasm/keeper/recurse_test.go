@@ -318,3 +318,28 @@ func TestLimitRecursiveQueryGas(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryWithGas(t *testing.T) {
+	contractAddr, parentCtx, keeper := initRecurseContract(t)
+
+	var lastGasUsed uint64
+	for _, msg := range []Recurse{{}, {Work: 50}, {Depth: 1, Work: 50}, {Depth: 4, Work: 50}} {
+		ctx := parentCtx.WithGasMeter(storetypes.NewGasMeter(400_000))
+		// when
+		data, gasUsed, err := keeper.QueryWithGas(ctx, contractAddr, buildRecurseQuery(t, msg))
+		// then
+		require.NoError(t, err)
+		var resp recurseResponse
+		require.NoError(t, json.Unmarshal(data, &resp))
+		assert.NotZero(t, gasUsed)
+		assert.Greater(t, gasUsed, lastGasUsed, "depth %d, work %d", msg.Depth, msg.Work)
+		assert.Equal(t, gasUsed, ctx.GasMeter().GasConsumed())
+		lastGasUsed = gasUsed
+	}
+
+	// and
+	ctx := parentCtx.WithGasMeter(storetypes.NewGasMeter(400_000))
+	_, gasUsed, err := keeper.QueryWithGas(ctx, RandomAccountAddress(t), buildRecurseQuery(t, Recurse{}))
+	require.Error(t, err)
+	assert.Equal(t, gasUsed, ctx.GasMeter().GasConsumed())
+}
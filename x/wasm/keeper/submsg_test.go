@@ -105,6 +105,56 @@ func TestDispatchSubMsgSuccessCase(t *testing.T) {
 	require.Len(t, sub.Events, 0)
 }
 
+// The payload of a submessage is not visible to the executed contract but returned with the reply.
+// Contracts can use it to correlate replies of execute messages without storing routing state.
+func TestDispatchSubMsgExecutePayloadRoundTrip(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectCapabilities)
+	keeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	_, beneficiary := keyPubAddr()
+
+	reflectID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect contract 1", nil)
+	require.NoError(t, err)
+
+	hackatomID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	initMsg := HackatomExampleInitMsg{Verifier: reflectAddr, Beneficiary: beneficiary}
+	hackatomAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, hackatomID, creator, nil, initMsg.GetBytes(t), "hackatom", sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+	require.NoError(t, err)
+
+	correlationID := []byte(`{"app_id":"my-correlation-id"}`)
+	reflectExec := testdata.ReflectHandleMsg{
+		ReflectSubMsg: &testdata.ReflectSubPayload{
+			Msgs: []wasmvmtypes.SubMsg{{
+				ID:      7,
+				Payload: correlationID,
+				Msg: wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{
+					ContractAddr: hackatomAddr.String(),
+					Msg:          []byte(`{"release":{}}`),
+					Funds:        []wasmvmtypes.Coin{},
+				}}},
+				ReplyOn: wasmvmtypes.ReplySuccess,
+			}},
+		},
+	}
+	// when
+	_, err = keepers.ContractKeeper.Execute(ctx, reflectAddr, creator, mustMarshal(t, reflectExec), nil)
+	require.NoError(t, err)
+
+	// then
+	queryRes, err := keeper.QuerySmart(ctx, reflectAddr, mustMarshal(t, testdata.ReflectQueryMsg{SubMsgResult: &testdata.SubCall{ID: 7}}))
+	require.NoError(t, err)
+	var res wasmvmtypes.Reply
+	require.NoError(t, json.Unmarshal(queryRes, &res))
+	assert.Equal(t, uint64(7), res.ID)
+	assert.Equal(t, correlationID, res.Payload)
+	require.NotNil(t, res.Result.Ok)
+}
+
 func TestDispatchSubMsgErrorHandling(t *testing.T) {
 	fundedDenom := "funds"
 	fundedAmount := 1_000_000
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	stdrand "math/rand"
 	"os"
 	"testing"
 	"time"
@@ -535,6 +537,20 @@ func RandomBech32AccountAddress(t testing.TB) string {
 	return RandomAccountAddress(t).String()
 }
 
+// RandomContract returns the address of a uniformly selected contract instance.
+// Returns false when no contract was instantiated, yet.
+func RandomContract(ctx context.Context, k Keeper, r *stdrand.Rand) (sdk.AccAddress, bool) {
+	var addrs []sdk.AccAddress
+	k.IterateContractInfo(ctx, func(addr sdk.AccAddress, _ types.ContractInfo) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	if len(addrs) == 0 {
+		return nil, false
+	}
+	return addrs[r.Intn(len(addrs))], true
+}
+
 type ExampleContract struct {
 	InitialAmount sdk.Coins
 	Creator       crypto.PrivKey
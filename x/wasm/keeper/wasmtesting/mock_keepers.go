@@ -119,7 +119,8 @@ var _ types.IBCContractKeeper = &IBCContractKeeperMock{}
 
 type IBCContractKeeperMock struct {
 	types.IBCContractKeeper
-	OnRecvPacketFn func(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.IBCPacketReceiveMsg) (ibcexported.Acknowledgement, error)
+	OnRecvPacketFn     func(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.IBCPacketReceiveMsg) (ibcexported.Acknowledgement, error)
+	ContractByPortIDFn func(ctx context.Context, portID string) (sdk.AccAddress, bool)
 
 	packets map[string]channeltypes.Packet
 }
@@ -131,6 +132,13 @@ func (m *IBCContractKeeperMock) OnRecvPacket(ctx sdk.Context, contractAddr sdk.A
 	return m.OnRecvPacketFn(ctx, contractAddr, msg)
 }
 
+func (m *IBCContractKeeperMock) ContractByPortID(ctx context.Context, portID string) (sdk.AccAddress, bool) {
+	if m.ContractByPortIDFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ContractByPortIDFn(ctx, portID)
+}
+
 func (m *IBCContractKeeperMock) LoadAsyncAckPacket(ctx context.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, error) {
 	if m.packets == nil {
 		m.packets = make(map[string]channeltypes.Packet)
@@ -0,0 +1,111 @@
+package v4
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToCodeCreatorIndexFn creates a secondary index entry for the creator of the code
+type AddToCodeCreatorIndexFn func(ctx context.Context, creator sdk.AccAddress, codeID uint64) error
+
+// AddToCodeChecksumIndexFn creates a secondary index entry for the checksum of the code
+type AddToCodeChecksumIndexFn func(ctx context.Context, checksum []byte, codeID uint64) error
+
+// AddToContractAdminIndexFn creates a secondary index entry for the admin of the contract
+type AddToContractAdminIndexFn func(ctx context.Context, admin, contractAddress sdk.AccAddress) error
+
+// AddToContractHeightIndexFn creates a secondary index entry for the instantiation height of the contract
+type AddToContractHeightIndexFn func(ctx context.Context, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) error
+
+// SetCountFn overwrites the value of the counter stored under the given key
+type SetCountFn func(ctx context.Context, countKey []byte, val uint64) error
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	IterateCodeInfos(ctx context.Context, cb func(uint64, types.CodeInfo) bool)
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+	GetContractHistory(ctx context.Context, contractAddr sdk.AccAddress) []types.ContractCodeHistoryEntry
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper                     wasmKeeper
+	addToCodeCreatorIndexFn    AddToCodeCreatorIndexFn
+	addToCodeChecksumIndexFn   AddToCodeChecksumIndexFn
+	addToContractAdminIndexFn  AddToContractAdminIndexFn
+	addToContractHeightIndexFn AddToContractHeightIndexFn
+	setCountFn                 SetCountFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(
+	k wasmKeeper,
+	codeCreatorFn AddToCodeCreatorIndexFn,
+	codeChecksumFn AddToCodeChecksumIndexFn,
+	contractAdminFn AddToContractAdminIndexFn,
+	contractHeightFn AddToContractHeightIndexFn,
+	setCountFn SetCountFn,
+) Migrator {
+	return Migrator{
+		keeper:                     k,
+		addToCodeCreatorIndexFn:    codeCreatorFn,
+		addToCodeChecksumIndexFn:   codeChecksumFn,
+		addToContractAdminIndexFn:  contractAdminFn,
+		addToContractHeightIndexFn: contractHeightFn,
+		setCountFn:                 setCountFn,
+	}
+}
+
+// Migrate4to5 migrates from version 4 to 5. It builds the code by creator, code by checksum,
+// contract by admin and contract by instantiation height indexes and sets the code and contract
+// counters with a single pass over all codes and a single pass over all contracts.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	var (
+		codes, contracts uint64
+		err              error
+	)
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, codeInfo types.CodeInfo) bool {
+		codes++
+		var creator sdk.AccAddress
+		if creator, err = sdk.AccAddressFromBech32(codeInfo.Creator); err != nil {
+			err = errorsmod.Wrapf(err, "creator of code %d", codeID)
+			return true
+		}
+		if err = m.addToCodeCreatorIndexFn(ctx, creator, codeID); err != nil {
+			return true
+		}
+		err = m.addToCodeChecksumIndexFn(ctx, codeInfo.CodeHash, codeID)
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		contracts++
+		if admin := contractInfo.AdminAddr(); admin != nil {
+			if err = m.addToContractAdminIndexFn(ctx, admin, contractAddr); err != nil {
+				return true
+			}
+		}
+		// same source as on instantiate and genesis import: the position of the first history entry
+		history := m.keeper.GetContractHistory(ctx, contractAddr)
+		if len(history) == 0 {
+			err = errorsmod.Wrapf(types.ErrNotFound, "history of contract %s", contractAddr)
+			return true
+		}
+		err = m.addToContractHeightIndexFn(ctx, history[0].Updated, contractAddr)
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := m.setCountFn(ctx, types.KeyCodeCount, codes); err != nil {
+		return err
+	}
+	return m.setCountFn(ctx, types.KeyContractCount, contracts)
+}
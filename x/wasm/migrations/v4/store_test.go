@@ -0,0 +1,78 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	AvailableCapabilities := []string{"iterator", "staking", "stargate", "cosmwasm_1_1"}
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	code1 := keeper.StoreRandomContract(t, ctx, keepers, &mock)
+	code2 := keeper.StoreRandomContract(t, ctx, keepers, &mock)
+	admin := keeper.RandomAccountAddress(t)
+	height1 := uint64(ctx.BlockHeight())
+	contract1, _, err := keepers.ContractKeeper.Instantiate(ctx, code1.CodeID, code1.CreatorAddr, admin, []byte("{}"), "label 1", nil)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	height2 := uint64(ctx.BlockHeight())
+	contract2, _, err := keepers.ContractKeeper.Instantiate(ctx, code1.CodeID, code1.CreatorAddr, nil, []byte("{}"), "label 2", nil)
+	require.NoError(t, err)
+
+	// remove keys
+	store := ctx.KVStore(keepers.WasmStoreKey)
+	store.Delete(types.GetCodeByCreatorSecondaryIndexKey(code1.CreatorAddr, code1.CodeID))
+	store.Delete(types.GetCodeByCreatorSecondaryIndexKey(code2.CreatorAddr, code2.CodeID))
+	store.Delete(types.GetCodeByChecksumSecondaryIndexKey(code1.Checksum, code1.CodeID))
+	store.Delete(types.GetCodeByChecksumSecondaryIndexKey(code2.Checksum, code2.CodeID))
+	store.Delete(types.GetContractByAdminSecondaryIndexKey(admin, contract1))
+	store.Delete(types.GetContractByHeightSecondaryIndexKey(height1, contract1))
+	store.Delete(types.GetContractByHeightSecondaryIndexKey(height2, contract2))
+	store.Delete(types.KeyCodeCount)
+	store.Delete(types.KeyContractCount)
+	require.Zero(t, wasmKeeper.CodeCount(ctx))
+	require.Zero(t, wasmKeeper.ContractCount(ctx))
+
+	// migrator
+	err = keeper.NewMigrator(*wasmKeeper, nil).Migrate4to5(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	gotCodeIDs, err := wasmKeeper.CodesByCreator(ctx, code1.CreatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{code1.CodeID}, gotCodeIDs)
+
+	gotCodeIDs, err = wasmKeeper.CodesByCreator(ctx, code2.CreatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{code2.CodeID}, gotCodeIDs)
+
+	assert.True(t, store.Has(types.GetCodeByChecksumSecondaryIndexKey(code1.Checksum, code1.CodeID)))
+	assert.True(t, store.Has(types.GetCodeByChecksumSecondaryIndexKey(code2.Checksum, code2.CodeID)))
+
+	gotContracts, err := wasmKeeper.ContractsByAdmin(ctx, admin)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{contract1}, gotContracts)
+
+	gotContracts, err = wasmKeeper.ContractsInstantiatedBetween(ctx, height1, height1)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{contract1}, gotContracts)
+
+	gotContracts, err = wasmKeeper.ContractsInstantiatedBetween(ctx, height2, height2)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{contract2}, gotContracts)
+
+	assert.Equal(t, uint64(2), wasmKeeper.CodeCount(ctx))
+	assert.Equal(t, uint64(2), wasmKeeper.ContractCount(ctx))
+}
@@ -92,6 +92,18 @@ var (
 
 	// ErrExceedMaxCallDepth error if max message stack size is exceeded
 	ErrExceedMaxCallDepth = errorsmod.Register(DefaultCodespace, 30, "max call depth exceeded")
+
+	// ErrCodeGasLimit error if the gas ceiling for a code is exceeded
+	ErrCodeGasLimit = errorsmod.Register(DefaultCodespace, 31, "out of gas for code")
+
+	// ErrContractPaused error if a call is made to a paused contract
+	ErrContractPaused = errorsmod.Register(DefaultCodespace, 32, "contract paused")
+
+	// ErrReplyGasLimit error if the gas ceiling for a reply call is exceeded
+	ErrReplyGasLimit = errorsmod.Register(DefaultCodespace, 33, "out of gas for reply")
+
+	// ErrUploadFrozen error if code is stored while uploads are frozen
+	ErrUploadFrozen = errorsmod.Register(DefaultCodespace, 34, "code uploads frozen")
 )
 
 // WasmVMErrorable mapped error type in wasmvm and are not redacted
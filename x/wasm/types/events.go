@@ -28,6 +28,10 @@ const (
 	EventTypeUpdateContractLabel    = "update_contract_label"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
 	EventTypePacketRecv             = "ibc_packet_received"
+	EventTypeEncodedMsgs            = "encoded_msgs"
+	EventTypeIBCTransfer            = "wasm_ibc_transfer"
+	EventTypePauseContract          = "pause_contract"
+	EventTypeUnpauseContract        = "unpause_contract"
 	// add new types to IsAcceptedEventOnRecvPacketErrorAck
 )
 
@@ -77,4 +81,10 @@ const (
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
 	AttributeKeyAckSuccess          = "success"
 	AttributeKeyAckError            = "error"
+	AttributeKeyMsgCount            = "msg_count"
+	AttributeKeyMsgTypeURL          = "msg_type_url"
+	AttributeKeyChannel             = "channel"
+	AttributeKeyReceiver            = "receiver"
+	AttributeKeyAmount              = "amount"
+	AttributeKeySender              = "sender"
 )
@@ -87,6 +87,8 @@ type ContractOpsKeeper interface {
 
 // IBCContractKeeper IBC lifecycle event handler
 type IBCContractKeeper interface {
+	// ContractByPortID returns the contract that owns the given IBC port
+	ContractByPortID(ctx context.Context, portID string) (sdk.AccAddress, bool)
 	OnOpenChannel(
 		ctx sdk.Context,
 		contractAddr sdk.AccAddress,
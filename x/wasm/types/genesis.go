@@ -47,6 +47,14 @@ func (c Code) ValidateBasic() error {
 	if err := validateWasmCode(c.CodeBytes, MaxProposalWasmSize); err != nil {
 		return errorsmod.Wrap(err, "code bytes")
 	}
+	for i, key := range c.SudoAllowList {
+		if key == "" {
+			return errorsmod.Wrapf(ErrEmpty, "sudo allow list key %d", i)
+		}
+	}
+	if len(c.Metadata) > MaxCodeMetadataSize {
+		return errorsmod.Wrapf(ErrLimit, "metadata cannot be longer than %d bytes", MaxCodeMetadataSize)
+	}
 	return nil
 }
 
@@ -77,6 +85,26 @@ func (c Contract) ValidateBasic() error {
 	return nil
 }
 
+// GenesisContract is a self-contained snapshot of a single contract together with its code.
+// It can be moved to another chain without a full genesis export.
+type GenesisContract struct {
+	Code     Code     `json:"code"`
+	Contract Contract `json:"contract"`
+}
+
+func (g GenesisContract) ValidateBasic() error {
+	if err := g.Code.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "code")
+	}
+	if err := g.Contract.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if g.Code.CodeID != g.Contract.ContractInfo.CodeID {
+		return errorsmod.Wrapf(ErrInvalid, "code id mismatch: %d != %d", g.Code.CodeID, g.Contract.ContractInfo.CodeID)
+	}
+	return nil
+}
+
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
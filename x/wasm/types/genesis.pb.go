@@ -34,6 +34,8 @@ type GenesisState struct {
 	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
 	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	// UploadFrozen is set when the upload of new code is frozen
+	UploadFrozen bool `protobuf:"varint,5,opt,name=upload_frozen,json=uploadFrozen,proto3" json:"upload_frozen,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +104,13 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetUploadFrozen() bool {
+	if m != nil {
+		return m.UploadFrozen
+	}
+	return false
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -109,6 +118,14 @@ type Code struct {
 	CodeBytes []byte   `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
 	// Pinned to wasmvm cache
 	Pinned bool `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// GasLimit is the max gas of a single call to a contract of this code. Zero
+	// when not set
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// SudoAllowList contains the allowed top level sudo message keys. Empty when
+	// not restricted
+	SudoAllowList []string `protobuf:"bytes,6,rep,name=sudo_allow_list,json=sudoAllowList,proto3" json:"sudo_allow_list,omitempty"`
+	// Metadata is the opaque metadata attached to the code
+	Metadata []byte `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
@@ -177,12 +194,37 @@ func (m *Code) GetPinned() bool {
 	return false
 }
 
+func (m *Code) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Code) GetSudoAllowList() []string {
+	if m != nil {
+		return m.SudoAllowList
+	}
+	return nil
+}
+
+func (m *Code) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress     string                     `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo        ContractInfo               `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState       []Model                    `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
 	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,4,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
+	// Paused contracts reject execute, sudo and migrate calls
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	// StateVersion is the state schema version of the contract. Zero when not set
+	StateVersion uint64 `protobuf:"varint,6,opt,name=state_version,json=stateVersion,proto3" json:"state_version,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -251,6 +293,20 @@ func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
 	return nil
 }
 
+func (m *Contract) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Contract) GetStateVersion() uint64 {
+	if m != nil {
+		return m.StateVersion
+	}
+	return 0
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -319,44 +375,52 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xae, 0x0d, 0xad, 0x57, 0xd8, 0x30, 0x63, 0x84, 0x6a, 0xa4, 0x55, 0x91, 0x50,
-	0x35, 0x41, 0xa3, 0x8d, 0x23, 0x17, 0xc8, 0x86, 0xa0, 0x4c, 0x20, 0x94, 0x1e, 0x90, 0x76, 0xa9,
-	0xd2, 0xd8, 0xeb, 0x2c, 0x96, 0xb8, 0xc4, 0x6e, 0x21, 0xdf, 0x82, 0x4f, 0x81, 0x38, 0x72, 0xe0,
-	0x43, 0xec, 0xc6, 0x84, 0x84, 0xc4, 0xa9, 0x42, 0xed, 0x01, 0x89, 0x4f, 0x81, 0xfc, 0x27, 0x59,
-	0xd4, 0xae, 0x17, 0xab, 0xf6, 0xf3, 0xbe, 0xbf, 0xbe, 0xef, 0xe3, 0x37, 0x06, 0x76, 0x40, 0x59,
-	0xf8, 0xd1, 0x67, 0xa1, 0x23, 0x97, 0xc9, 0x9e, 0x33, 0xc4, 0x11, 0x66, 0x84, 0x75, 0x46, 0x31,
-	0xe5, 0x14, 0x6e, 0xa6, 0x7a, 0x47, 0x2e, 0x93, 0xbd, 0xfa, 0xd6, 0x90, 0x0e, 0xa9, 0x14, 0x1d,
-	0xf1, 0x4b, 0xc5, 0xd5, 0x77, 0x96, 0x38, 0x3c, 0x19, 0x61, 0x4d, 0xa9, 0xdf, 0xf4, 0x43, 0x12,
-	0x51, 0x47, 0xae, 0xfa, 0xe8, 0xae, 0x48, 0xa0, 0xac, 0xaf, 0x48, 0x6a, 0xa3, 0xa4, 0xd6, 0x8f,
-	0x22, 0xa8, 0xbd, 0x50, 0x55, 0xf4, 0xb8, 0xcf, 0x31, 0x7c, 0x02, 0xcc, 0x91, 0x1f, 0xfb, 0x21,
-	0xb3, 0x8c, 0xa6, 0xd1, 0x5e, 0xdf, 0xb7, 0x3a, 0x8b, 0x55, 0x75, 0xde, 0x4a, 0xdd, 0xad, 0x9e,
-	0x4f, 0x1b, 0x85, 0xaf, 0x7f, 0xbf, 0xed, 0x1a, 0x9e, 0x4e, 0x81, 0xaf, 0x40, 0x39, 0xa0, 0x08,
-	0x33, 0xab, 0xd8, 0x5c, 0x6b, 0xaf, 0xef, 0x6f, 0x2f, 0xe7, 0x1e, 0x50, 0x84, 0xdd, 0x1d, 0x91,
-	0xf9, 0x6f, 0xda, 0xd8, 0x90, 0xc1, 0x0f, 0x69, 0x48, 0x38, 0x0e, 0x47, 0x3c, 0x51, 0x30, 0x85,
-	0x80, 0xc7, 0xa0, 0x1a, 0xd0, 0x88, 0xc7, 0x7e, 0xc0, 0x99, 0xb5, 0x26, 0x79, 0xf5, 0xab, 0x78,
-	0x2a, 0xc4, 0x6d, 0x6a, 0xe6, 0xad, 0x2c, 0x69, 0x91, 0x7b, 0x89, 0x13, 0x6c, 0x86, 0x3f, 0x8c,
-	0x71, 0x14, 0x60, 0x66, 0x95, 0x56, 0xb1, 0x7b, 0x3a, 0xe4, 0x92, 0x9d, 0x25, 0x2d, 0xb1, 0x33,
-	0xa5, 0xf5, 0xc5, 0x00, 0x25, 0xd1, 0x25, 0xbc, 0x0f, 0xae, 0x89, 0x4e, 0xfa, 0x04, 0x49, 0x2b,
-	0x4b, 0x2e, 0x98, 0x4d, 0x1b, 0xa6, 0x90, 0xba, 0x87, 0x9e, 0x29, 0xa4, 0x2e, 0x82, 0xae, 0xe8,
-	0x52, 0x04, 0x45, 0x27, 0xd4, 0x2a, 0x4a, 0xc7, 0xeb, 0x57, 0xbb, 0xd6, 0x8d, 0x4e, 0x68, 0xde,
-	0xf3, 0x4a, 0xa0, 0x0f, 0xe1, 0x3d, 0x00, 0x24, 0x63, 0x90, 0x70, 0x2c, 0xac, 0x32, 0xda, 0x35,
-	0x4f, 0x52, 0x5d, 0x71, 0x00, 0xb7, 0x81, 0x39, 0x22, 0x51, 0x84, 0x91, 0x55, 0x6a, 0x1a, 0xed,
-	0x8a, 0xa7, 0x77, 0xad, 0x5f, 0x45, 0x50, 0x49, 0xed, 0x83, 0x07, 0x60, 0x33, 0xb5, 0xa7, 0xef,
-	0x23, 0x14, 0x63, 0xa6, 0x06, 0xa0, 0xea, 0x5a, 0x3f, 0xbf, 0x3f, 0xda, 0xd2, 0x33, 0xf3, 0x4c,
-	0x29, 0x3d, 0x1e, 0x93, 0x68, 0xe8, 0x6d, 0xa4, 0x19, 0xfa, 0x18, 0xbe, 0x01, 0xd7, 0x33, 0x48,
-	0xae, 0x21, 0x7b, 0xf5, 0xb5, 0x2d, 0x36, 0x55, 0x0b, 0x72, 0x02, 0xec, 0x82, 0x1b, 0x19, 0x8f,
-	0x89, 0xe9, 0xd4, 0x73, 0x70, 0x67, 0x19, 0xf8, 0x9a, 0x22, 0x7c, 0x96, 0x27, 0x65, 0x95, 0xa8,
-	0xb1, 0x26, 0xe0, 0x76, 0x86, 0x92, 0x66, 0x9d, 0x12, 0xc6, 0x69, 0x9c, 0xe8, 0xdb, 0xdf, 0x5d,
-	0x5d, 0xa2, 0xf0, 0xfe, 0xa5, 0x0a, 0x7e, 0x1e, 0xf1, 0x38, 0xc9, 0xff, 0x49, 0x36, 0x6c, 0xb9,
-	0xa0, 0x96, 0x0b, 0x2a, 0xe9, 0xe4, 0xc0, 0x26, 0x30, 0x09, 0xea, 0xbf, 0xc7, 0x89, 0x34, 0xb3,
-	0xe6, 0x56, 0x67, 0xd3, 0x46, 0xb9, 0x7b, 0x78, 0x84, 0x13, 0xaf, 0x4c, 0xd0, 0x11, 0x4e, 0xe0,
-	0x16, 0x28, 0x4f, 0xfc, 0xb3, 0x31, 0x96, 0x5e, 0x95, 0x3c, 0xb5, 0x71, 0x9f, 0x9e, 0xcf, 0x6c,
-	0xe3, 0x62, 0x66, 0x1b, 0x7f, 0x66, 0xb6, 0xf1, 0x79, 0x6e, 0x17, 0x2e, 0xe6, 0x76, 0xe1, 0xf7,
-	0xdc, 0x2e, 0x1c, 0x3f, 0x18, 0x12, 0x7e, 0x3a, 0x1e, 0x74, 0x02, 0x1a, 0x3a, 0x07, 0x94, 0x85,
-	0xef, 0xd2, 0x77, 0x00, 0x39, 0x9f, 0xd4, 0x7b, 0x20, 0x1f, 0x83, 0x81, 0x29, 0xbf, 0xef, 0xc7,
-	0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x86, 0xe3, 0xf2, 0x58, 0x75, 0x04, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xbc, 0xb8, 0xc9, 0x36, 0x7d, 0xda, 0x67, 0x9f, 0x3e, 0xc5, 0x84, 0xe2, 0x44,
+	0xa9, 0x54, 0x45, 0x15, 0x24, 0x6a, 0x39, 0x72, 0xa1, 0x6e, 0x79, 0x09, 0x2d, 0x08, 0xb9, 0x12,
+	0x48, 0xbd, 0x58, 0x5b, 0xef, 0x36, 0x5d, 0x61, 0x7b, 0x83, 0x77, 0x93, 0x62, 0xbe, 0x00, 0x57,
+	0xc4, 0xa7, 0xe0, 0xc8, 0x81, 0x0f, 0xd1, 0x63, 0xc5, 0x89, 0x53, 0x84, 0xd2, 0x03, 0x12, 0x67,
+	0x3e, 0x00, 0xda, 0x5d, 0xc7, 0x8d, 0x9a, 0xf6, 0xb2, 0xca, 0xfc, 0x67, 0xe6, 0x97, 0xd9, 0x99,
+	0xf1, 0x02, 0xdb, 0x67, 0x3c, 0x3c, 0x45, 0x3c, 0xec, 0xa8, 0x63, 0xb8, 0xd9, 0xe9, 0x91, 0x88,
+	0x70, 0xca, 0xdb, 0xfd, 0x98, 0x09, 0x06, 0x97, 0x26, 0xfe, 0xb6, 0x3a, 0x86, 0x9b, 0xb5, 0xe5,
+	0x1e, 0xeb, 0x31, 0xe5, 0xec, 0xc8, 0x5f, 0x3a, 0xae, 0xb6, 0x3a, 0xc3, 0x11, 0x49, 0x9f, 0xa4,
+	0x94, 0xda, 0xbf, 0x28, 0xa4, 0x11, 0xeb, 0xa8, 0x33, 0x95, 0x6e, 0xcb, 0x04, 0xc6, 0x3d, 0x4d,
+	0xd2, 0x86, 0x76, 0x35, 0xff, 0xe4, 0x41, 0xf5, 0xa9, 0xae, 0xe2, 0x40, 0x20, 0x41, 0xe0, 0x43,
+	0x60, 0xf6, 0x51, 0x8c, 0x42, 0x6e, 0x19, 0x0d, 0xa3, 0x35, 0xbf, 0x65, 0xb5, 0xaf, 0x56, 0xd5,
+	0x7e, 0xa5, 0xfc, 0x4e, 0xe5, 0x6c, 0x54, 0xcf, 0x7d, 0xf9, 0xf5, 0x75, 0xc3, 0x70, 0xd3, 0x14,
+	0xf8, 0x1c, 0x94, 0x7c, 0x86, 0x09, 0xb7, 0xf2, 0x8d, 0x42, 0x6b, 0x7e, 0x6b, 0x65, 0x36, 0x77,
+	0x87, 0x61, 0xe2, 0xac, 0xca, 0xcc, 0xdf, 0xa3, 0xfa, 0xa2, 0x0a, 0xbe, 0xc7, 0x42, 0x2a, 0x48,
+	0xd8, 0x17, 0x89, 0x86, 0x69, 0x04, 0x3c, 0x04, 0x15, 0x9f, 0x45, 0x22, 0x46, 0xbe, 0xe0, 0x56,
+	0x41, 0xf1, 0x6a, 0xd7, 0xf1, 0x74, 0x88, 0xd3, 0x48, 0x99, 0xff, 0x65, 0x49, 0x57, 0xb9, 0x97,
+	0x38, 0xc9, 0xe6, 0xe4, 0xdd, 0x80, 0x44, 0x3e, 0xe1, 0x56, 0xf1, 0x26, 0xf6, 0x41, 0x1a, 0x72,
+	0xc9, 0xce, 0x92, 0x66, 0xd8, 0x99, 0x07, 0xae, 0x81, 0x85, 0x41, 0x3f, 0x60, 0x08, 0x7b, 0xc7,
+	0x31, 0xfb, 0x40, 0x22, 0xab, 0xd4, 0x30, 0x5a, 0x65, 0xb7, 0xaa, 0xc5, 0x27, 0x4a, 0x6b, 0x7e,
+	0xcc, 0x83, 0xa2, 0x6c, 0x05, 0x5c, 0x03, 0x73, 0xf2, 0xba, 0x1e, 0xc5, 0xaa, 0xdf, 0x45, 0x07,
+	0x8c, 0x47, 0x75, 0x53, 0xba, 0xba, 0xbb, 0xae, 0x29, 0x5d, 0x5d, 0x0c, 0x1d, 0xd9, 0x0a, 0x19,
+	0x14, 0x1d, 0x33, 0x2b, 0xaf, 0xc6, 0x52, 0xbb, 0xbe, 0xb5, 0xdd, 0xe8, 0x98, 0x4d, 0x0f, 0xa6,
+	0xec, 0xa7, 0x22, 0xbc, 0x0b, 0x80, 0x62, 0x1c, 0x25, 0x82, 0xc8, 0x7e, 0x1a, 0xad, 0xaa, 0xab,
+	0xa8, 0x8e, 0x14, 0xe0, 0x0a, 0x30, 0xfb, 0x34, 0x8a, 0x08, 0xb6, 0x8a, 0xaa, 0xdc, 0xd4, 0x82,
+	0x77, 0x40, 0xa5, 0x87, 0xb8, 0x17, 0xd0, 0x90, 0x0a, 0x75, 0x93, 0xa2, 0x5b, 0xee, 0x21, 0xbe,
+	0x2f, 0x6d, 0xb8, 0x0e, 0x16, 0xf9, 0x00, 0x33, 0x0f, 0x05, 0x01, 0x3b, 0xf5, 0x02, 0xca, 0x85,
+	0x65, 0x36, 0x0a, 0xad, 0x8a, 0xbb, 0x20, 0xe5, 0x6d, 0xa9, 0xee, 0x53, 0x2e, 0x60, 0x0d, 0x94,
+	0x43, 0x22, 0x10, 0x46, 0x02, 0x59, 0x73, 0xea, 0x9f, 0x33, 0xbb, 0xf9, 0xb9, 0x00, 0xca, 0x93,
+	0x21, 0xc2, 0x1d, 0xb0, 0x34, 0x19, 0x92, 0x87, 0x30, 0x8e, 0x09, 0xd7, 0x6b, 0x58, 0x71, 0xac,
+	0xef, 0xdf, 0xee, 0x2f, 0xa7, 0x9b, 0xbb, 0xad, 0x3d, 0x07, 0x22, 0xa6, 0x51, 0xcf, 0x5d, 0x9c,
+	0x64, 0xa4, 0x32, 0x7c, 0x09, 0x16, 0x32, 0xc8, 0x54, 0xc7, 0xec, 0x9b, 0x97, 0xe7, 0x6a, 0xd7,
+	0xaa, 0xfe, 0x94, 0x03, 0x76, 0xc1, 0x3f, 0x19, 0x8f, 0xcb, 0x6f, 0x24, 0xdd, 0xc6, 0x5b, 0xb3,
+	0xc0, 0x17, 0x0c, 0x93, 0x60, 0x9a, 0x94, 0x55, 0xa2, 0x3f, 0x2e, 0x0a, 0xfe, 0xcf, 0x50, 0x6a,
+	0x1a, 0x27, 0x94, 0x0b, 0x16, 0x27, 0xe9, 0x0e, 0x6e, 0xdc, 0x5c, 0xa2, 0x1c, 0xee, 0x33, 0x1d,
+	0xfc, 0x38, 0x12, 0x71, 0x32, 0xfd, 0x27, 0xd9, 0xca, 0x4f, 0x05, 0xa9, 0x81, 0xa2, 0x01, 0x27,
+	0x38, 0xdd, 0xbf, 0xd4, 0x92, 0xeb, 0xa9, 0x2e, 0xe1, 0x0d, 0x49, 0xcc, 0x29, 0x8b, 0x2c, 0x53,
+	0x0d, 0xb5, 0xaa, 0xc4, 0xd7, 0x5a, 0x6b, 0x3a, 0xa0, 0x3c, 0x59, 0x7e, 0xd8, 0x00, 0x26, 0xc5,
+	0xde, 0x5b, 0x92, 0xa8, 0x49, 0x54, 0x9d, 0xca, 0x78, 0x54, 0x2f, 0x75, 0x77, 0xf7, 0x48, 0xe2,
+	0x96, 0x28, 0xde, 0x23, 0x09, 0x5c, 0x06, 0xa5, 0x21, 0x0a, 0x06, 0x44, 0x35, 0xba, 0xe8, 0x6a,
+	0xc3, 0x79, 0x74, 0x36, 0xb6, 0x8d, 0xf3, 0xb1, 0x6d, 0xfc, 0x1c, 0xdb, 0xc6, 0xa7, 0x0b, 0x3b,
+	0x77, 0x7e, 0x61, 0xe7, 0x7e, 0x5c, 0xd8, 0xb9, 0xc3, 0xf5, 0x1e, 0x15, 0x27, 0x83, 0xa3, 0xb6,
+	0xcf, 0xc2, 0xce, 0x0e, 0xe3, 0xe1, 0x9b, 0xc9, 0x53, 0x86, 0x3b, 0xef, 0xf5, 0x93, 0xa6, 0xde,
+	0xb3, 0x23, 0x53, 0x3d, 0x51, 0x0f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x06, 0x93, 0xd1, 0xa1,
+	0x38, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -379,6 +443,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UploadFrozen {
+		i--
+		if m.UploadFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -454,6 +528,27 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SudoAllowList) > 0 {
+		for iNdEx := len(m.SudoAllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SudoAllowList[iNdEx])
+			copy(dAtA[i:], m.SudoAllowList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SudoAllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Pinned {
 		i--
 		if m.Pinned {
@@ -509,6 +604,21 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StateVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StateVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.ContractCodeHistory) > 0 {
 		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -630,6 +740,9 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.UploadFrozen {
+		n += 2
+	}
 	return n
 }
 
@@ -651,6 +764,19 @@ func (m *Code) Size() (n int) {
 	if m.Pinned {
 		n += 2
 	}
+	if m.GasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.GasLimit))
+	}
+	if len(m.SudoAllowList) > 0 {
+		for _, s := range m.SudoAllowList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -678,6 +804,12 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Paused {
+		n += 2
+	}
+	if m.StateVersion != 0 {
+		n += 1 + sovGenesis(uint64(m.StateVersion))
+	}
 	return n
 }
 
@@ -869,6 +1001,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadFrozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UploadFrozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1026,6 +1178,91 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Pinned = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoAllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoAllowList = append(m.SudoAllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1210,6 +1447,45 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateVersion", wireType)
+			}
+			m.StateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -36,9 +36,24 @@ var (
 	ContractsByCreatorPrefix                       = []byte{0x09}
 	ParamsKey                                      = []byte{0x10}
 	AsyncAckKeyPrefix                              = []byte{0x11}
+	CodeGasLimitPrefix                             = []byte{0x12}
+	SudoAllowListPrefix                            = []byte{0x13}
+	ContractPausedPrefix                           = []byte{0x14}
+	CodesByCreatorPrefix                           = []byte{0x15}
+	ContractStateVersionPrefix                     = []byte{0x16}
+	ContractsByAdminPrefix                         = []byte{0x17}
+	CodeMetadataPrefix                             = []byte{0x18}
+	CounterKeyPrefix                               = []byte{0x19}
+	ContractsByHeightPrefix                        = []byte{0x1a}
+	UploadFrozenKey                                = []byte{0x1b}
+	CodesByChecksumPrefix                          = []byte{0x1c}
+	ContractByPortIDPrefix                         = []byte{0x1d}
 
 	KeySequenceCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeySequenceInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+
+	KeyCodeCount     = append(CounterKeyPrefix, []byte("codes")...)
+	KeyContractCount = append(CounterKeyPrefix, []byte("contracts")...)
 )
 
 // GetCodeKey constructs the key for retrieving the ID for the WASM code
@@ -58,6 +73,51 @@ func GetContractsByCreatorPrefix(addr sdk.AccAddress) []byte {
 	return append(ContractsByCreatorPrefix, bz...)
 }
 
+// GetCodesByCreatorPrefix returns the code ids by creator prefix
+func GetCodesByCreatorPrefix(addr sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(addr)
+	return append(CodesByCreatorPrefix, bz...)
+}
+
+// GetContractsByAdminPrefix returns the contracts by admin prefix
+func GetContractsByAdminPrefix(addr sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(addr)
+	return append(ContractsByAdminPrefix, bz...)
+}
+
+// GetContractByAdminSecondaryIndexKey returns the key for the contract by admin index: `<prefix><adminAddress length><adminAddress><contractAddress>`
+func GetContractByAdminSecondaryIndexKey(admin, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByAdminPrefix(admin), contractAddr...)
+}
+
+// GetContractByHeightSecondaryIndexKey returns the key for the contract by instantiation height index: `<prefix><height><contractAddress>`
+func GetContractByHeightSecondaryIndexKey(height uint64, contractAddr sdk.AccAddress) []byte {
+	prefixLen := len(ContractsByHeightPrefix)
+	r := make([]byte, prefixLen+8+len(contractAddr))
+	copy(r[0:], ContractsByHeightPrefix)
+	binary.BigEndian.PutUint64(r[prefixLen:], height)
+	copy(r[prefixLen+8:], contractAddr)
+	return r
+}
+
+// GetCodesByChecksumPrefix returns the code ids by checksum prefix: `<prefix><checksum length><checksum>`
+func GetCodesByChecksumPrefix(checksum []byte) []byte {
+	r := make([]byte, 0, len(CodesByChecksumPrefix)+1+len(checksum))
+	r = append(r, CodesByChecksumPrefix...)
+	r = append(r, byte(len(checksum)))
+	return append(r, checksum...)
+}
+
+// GetCodeByChecksumSecondaryIndexKey returns the key for the code by checksum index: `<prefix><checksum length><checksum><codeID>`
+func GetCodeByChecksumSecondaryIndexKey(checksum []byte, codeID uint64) []byte {
+	return append(GetCodesByChecksumPrefix(checksum), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeByCreatorSecondaryIndexKey returns the key for the code by creator index: `<prefix><creatorAddress length><creatorAddress><codeID>`
+func GetCodeByCreatorSecondaryIndexKey(creator sdk.AccAddress, codeID uint64) []byte {
+	return append(GetCodesByCreatorPrefix(creator), sdk.Uint64ToBigEndian(codeID)...)
+}
+
 // GetContractStorePrefix returns the store prefix for the WASM contract instance
 func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
@@ -103,6 +163,15 @@ func GetContractByCodeIDSecondaryIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetSudoAllowListPrefix returns the store prefix for the allowed sudo message keys of a code id
+func GetSudoAllowListPrefix(codeID uint64) []byte {
+	prefixLen := len(SudoAllowListPrefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], SudoAllowListPrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
 // GetContractByCreatorSecondaryIndexKey returns the key for the second index: `<prefix><creatorAddress length><created time><creatorAddress><contractAddr>`
 func GetContractByCreatorSecondaryIndexKey(bz, position []byte, contractAddr sdk.AccAddress) []byte {
 	prefixBytes := GetContractsByCreatorPrefix(bz)
@@ -136,6 +205,39 @@ func GetContractCodeHistoryElementPrefix(contractAddr sdk.AccAddress) []byte {
 	return r
 }
 
+// GetCodeGasLimitKey returns the key for the gas ceiling of a code id
+func GetCodeGasLimitKey(codeID uint64) []byte {
+	prefixLen := len(CodeGasLimitPrefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], CodeGasLimitPrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
+// GetCodeMetadataKey returns the key for the metadata of a code id
+func GetCodeMetadataKey(codeID uint64) []byte {
+	prefixLen := len(CodeMetadataPrefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], CodeMetadataPrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
+// GetContractPausedKey returns the key for the pause flag of a contract
+func GetContractPausedKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractPausedPrefix, contractAddr...)
+}
+
+// GetContractByPortIDKey returns the key for the contract bound to a rebound IBC port id
+func GetContractByPortIDKey(portID string) []byte {
+	return append(ContractByPortIDPrefix, []byte(portID)...)
+}
+
+// GetContractStateVersionKey returns the key for the state schema version of a contract
+func GetContractStateVersionKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractStateVersionPrefix, contractAddr...)
+}
+
 // GetPinnedCodeIndexPrefix returns the key prefix for a code id pinned into the wasmvm cache
 func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 	prefixLen := len(PinnedCodeIndexPrefix)
@@ -190,6 +190,11 @@ var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// CodeDeduplication returns the existing code id when wasm code is uploaded
+	// that has the same checksum and instantiate config as a stored code. The
+	// stored code keeps its original creator, so that creator-only operations
+	// on it are not available to the uploader.
+	CodeDeduplication bool `protobuf:"varint,3,opt,name=code_deduplication,json=codeDeduplication,proto3" json:"code_deduplication,omitempty" yaml:"code_deduplication"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -492,84 +497,86 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
 
 var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0x4e, 0x62, 0x4f, 0x4c, 0xd9, 0x0e, 0xa9, 0xea, 0x98, 0xc8, 0x36, 0x4b, 0x09,
-	0x6d, 0xda, 0xda, 0xad, 0x41, 0x15, 0xea, 0xa1, 0x92, 0x3f, 0xb6, 0xcd, 0x56, 0x8a, 0x6d, 0xad,
-	0x5d, 0x4a, 0x90, 0xca, 0x6a, 0x3f, 0xc6, 0xce, 0x50, 0x7b, 0xc7, 0xda, 0x19, 0xa7, 0xf6, 0x7f,
-	0x80, 0x8c, 0x90, 0x38, 0x70, 0x40, 0x48, 0x96, 0x90, 0x40, 0xd0, 0x63, 0x0f, 0xfd, 0x07, 0xb8,
-	0x55, 0x9c, 0x2a, 0x4e, 0x9c, 0x2c, 0x70, 0x0f, 0xe5, 0x9c, 0x03, 0x87, 0x9e, 0xd0, 0xce, 0xd8,
-	0xf5, 0x8a, 0x7e, 0xc4, 0x70, 0x59, 0xcd, 0xbc, 0xf7, 0x7e, 0xbf, 0x79, 0xef, 0xf7, 0x66, 0xdf,
-	0x2e, 0xd8, 0xb2, 0x09, 0xed, 0xde, 0x33, 0x69, 0x37, 0xcf, 0x1f, 0x87, 0x97, 0xf3, 0x6c, 0xd8,
-	0x43, 0x34, 0xd7, 0xf3, 0x08, 0x23, 0x50, 0x9e, 0x7b, 0x73, 0xfc, 0x71, 0x78, 0x39, 0xb5, 0xe9,
-	0x5b, 0x08, 0x35, 0xb8, 0x3f, 0x2f, 0x36, 0x22, 0x38, 0xb5, 0xd1, 0x26, 0x6d, 0x22, 0xec, 0xfe,
-	0x6a, 0x66, 0xdd, 0x6c, 0x13, 0xd2, 0xee, 0xa0, 0x3c, 0xdf, 0x59, 0xfd, 0x56, 0xde, 0x74, 0x87,
-	0x33, 0xd7, 0x49, 0xb3, 0x8b, 0x5d, 0x92, 0xe7, 0x4f, 0x61, 0x52, 0xee, 0x80, 0x37, 0x8b, 0xb6,
-	0x8d, 0x28, 0x6d, 0x0e, 0x7b, 0xa8, 0x6e, 0x7a, 0x66, 0x17, 0x56, 0xc0, 0xca, 0xa1, 0xd9, 0xe9,
-	0xa3, 0xa4, 0x94, 0x95, 0xce, 0x9e, 0x28, 0x6c, 0xe5, 0xfe, 0x9d, 0x53, 0x6e, 0x81, 0x28, 0xc9,
-	0x47, 0x93, 0x4c, 0x62, 0x68, 0x76, 0x3b, 0x57, 0x15, 0x0e, 0x52, 0x74, 0x01, 0xbe, 0x1a, 0xfd,
-	0xf6, 0xfb, 0x8c, 0xa4, 0xfc, 0x2c, 0x81, 0x84, 0x88, 0x2e, 0x13, 0xb7, 0x85, 0xdb, 0xb0, 0x01,
-	0x40, 0x0f, 0x79, 0x5d, 0x4c, 0x29, 0x26, 0xee, 0x52, 0x27, 0x9c, 0x3a, 0x9a, 0x64, 0x4e, 0x8a,
-	0x13, 0x16, 0x48, 0x45, 0x0f, 0xd0, 0xc0, 0x2b, 0x20, 0x6e, 0x3a, 0x8e, 0x87, 0x28, 0x45, 0x34,
-	0x19, 0xc9, 0x46, 0xce, 0xc6, 0x4b, 0xc9, 0xdf, 0x1e, 0x5e, 0xdc, 0x98, 0xa9, 0x55, 0x14, 0xbe,
-	0x06, 0xf3, 0xb0, 0xdb, 0xd6, 0x17, 0xa1, 0x22, 0xc7, 0x9b, 0xd1, 0x58, 0x58, 0x8e, 0x28, 0xdf,
-	0x84, 0xc1, 0x2a, 0xaf, 0x9f, 0x42, 0x06, 0xa0, 0x4d, 0x1c, 0x64, 0xf4, 0x7b, 0x1d, 0x62, 0x3a,
-	0x86, 0xc9, 0x73, 0xe1, 0xb9, 0xae, 0x17, 0xd2, 0xaf, 0xca, 0x55, 0xd4, 0x57, 0xda, 0x7e, 0x34,
-	0xc9, 0x84, 0x8e, 0x26, 0x99, 0x4d, 0x91, 0xf1, 0x8b, 0x3c, 0xca, 0xfd, 0xa7, 0x0f, 0x76, 0x24,
-	0x5d, 0xf6, 0x3d, 0xb7, 0xb8, 0x43, 0xe0, 0xe1, 0x57, 0x12, 0x48, 0x63, 0x97, 0x32, 0xd3, 0x65,
-	0xd8, 0x64, 0xc8, 0x70, 0x50, 0xcb, 0xec, 0x77, 0x98, 0x11, 0x90, 0x2b, 0xbc, 0x84, 0x5c, 0xe7,
-	0x8e, 0x26, 0x99, 0xf7, 0xc4, 0xe1, 0xaf, 0x67, 0x53, 0xf4, 0xad, 0x40, 0x40, 0x45, 0xf8, 0xeb,
-	0xcf, 0xdd, 0x5c, 0x9c, 0x90, 0xf2, 0x8b, 0x04, 0x62, 0x65, 0xe2, 0x20, 0xcd, 0x6d, 0x11, 0xf8,
-	0x36, 0x88, 0xf3, 0x82, 0x0e, 0x4c, 0x7a, 0xc0, 0xf5, 0x48, 0xe8, 0x31, 0xdf, 0xb0, 0x6b, 0xd2,
-	0x03, 0x58, 0x00, 0x6b, 0xb6, 0x87, 0x4c, 0x46, 0x3c, 0x9e, 0xe7, 0xeb, 0x5a, 0x30, 0x0f, 0x84,
-	0x9f, 0x00, 0x18, 0x4c, 0xd2, 0xe6, 0x1a, 0x26, 0x57, 0x96, 0x52, 0x3a, 0xee, 0x2b, 0x2d, 0xc4,
-	0x3c, 0x19, 0x20, 0x11, 0xde, 0x9b, 0xd1, 0x58, 0x44, 0x8e, 0xde, 0x8c, 0xc6, 0xa2, 0xf2, 0x8a,
-	0xf2, 0x30, 0x02, 0x12, 0x65, 0xe2, 0x32, 0xcf, 0xb4, 0x19, 0xaf, 0xe3, 0x5d, 0xb0, 0xc6, 0xeb,
-	0xc0, 0x0e, 0xaf, 0x22, 0x5a, 0x02, 0xd3, 0x49, 0x66, 0x95, 0x97, 0x59, 0xd1, 0x57, 0x7d, 0x97,
-	0xe6, 0xfc, 0xaf, 0x7a, 0x72, 0x60, 0xc5, 0x74, 0xba, 0xd8, 0x4d, 0x46, 0x8e, 0x41, 0x88, 0x30,
-	0xb8, 0x01, 0x56, 0x3a, 0xa6, 0x85, 0x3a, 0xc9, 0xa8, 0x1f, 0xaf, 0x8b, 0x0d, 0xbc, 0x36, 0x3b,
-	0x19, 0x39, 0x33, 0x29, 0xce, 0xbc, 0x44, 0x0a, 0x8b, 0x92, 0x4e, 0x9f, 0xa1, 0xe6, 0xa0, 0x4e,
-	0x28, 0x66, 0x98, 0xb8, 0xfa, 0x1c, 0x04, 0x2f, 0x82, 0x75, 0x6c, 0xd9, 0x46, 0x8f, 0x78, 0xcc,
-	0x2f, 0x71, 0x95, 0xe7, 0xf2, 0xc6, 0x74, 0x92, 0x89, 0x6b, 0xa5, 0x72, 0x9d, 0x78, 0x4c, 0xab,
-	0xe8, 0x71, 0x6c, 0xd9, 0x7c, 0xe9, 0xc0, 0x4b, 0x20, 0x81, 0x2d, 0xbb, 0xf0, 0x3c, 0x7e, 0x8d,
-	0xc7, 0x9f, 0x98, 0x4e, 0x32, 0x40, 0x2b, 0x95, 0x0b, 0x33, 0x00, 0xf0, 0x63, 0x66, 0x88, 0xcf,
-	0x40, 0x1c, 0x0d, 0x18, 0x72, 0xf9, 0xa5, 0x8c, 0xf1, 0x14, 0x37, 0x72, 0x62, 0xec, 0xe4, 0xe6,
-	0x63, 0x27, 0x57, 0x74, 0x87, 0xa5, 0x9d, 0x5f, 0x1f, 0x5e, 0xdc, 0x7e, 0x21, 0xf7, 0x60, 0x2f,
-	0xd4, 0x39, 0x8f, 0xbe, 0xa0, 0xbc, 0x1a, 0xfd, 0xcb, 0x9f, 0x1d, 0x5f, 0x86, 0x41, 0x72, 0x1e,
-	0xea, 0xf7, 0x66, 0x17, 0x53, 0x46, 0xbc, 0xa1, 0xea, 0x32, 0x6f, 0x08, 0xeb, 0x20, 0x4e, 0x7a,
-	0xc8, 0x33, 0xd9, 0x62, 0x8c, 0x14, 0x72, 0xaf, 0x3c, 0x29, 0x00, 0xaf, 0xcd, 0x51, 0xfe, 0xdb,
-	0xa2, 0x2f, 0x48, 0x82, 0x97, 0x22, 0xfc, 0xca, 0x4b, 0x71, 0x0d, 0xac, 0xf5, 0x7b, 0x0e, 0x6f,
-	0x4d, 0xe4, 0xbf, 0xb4, 0x66, 0x06, 0x82, 0x1f, 0x81, 0x48, 0x97, 0xb6, 0x79, 0xbb, 0x13, 0xa5,
-	0xed, 0x67, 0x93, 0x0c, 0xd4, 0xcd, 0x7b, 0xf3, 0x2c, 0xf7, 0x10, 0xa5, 0x66, 0x1b, 0x7d, 0xf7,
-	0xf4, 0xc1, 0xce, 0x3a, 0x76, 0x3b, 0xd8, 0x45, 0xc6, 0xe7, 0x94, 0xb8, 0xba, 0x0f, 0x51, 0x74,
-	0x00, 0x5f, 0x24, 0x86, 0xef, 0x80, 0x84, 0xd5, 0x21, 0xf6, 0x5d, 0xe3, 0x00, 0xe1, 0xf6, 0x01,
-	0x13, 0xd7, 0x59, 0x5f, 0xe7, 0xb6, 0x5d, 0x6e, 0x82, 0x9b, 0x20, 0xc6, 0x06, 0x06, 0x76, 0x1d,
-	0x34, 0x10, 0x85, 0xe9, 0x6b, 0x6c, 0xa0, 0xf9, 0x5b, 0x05, 0x81, 0x95, 0x3d, 0xe2, 0xa0, 0x0e,
-	0xbc, 0x0e, 0x22, 0x77, 0xd1, 0x50, 0xbc, 0xd2, 0xa5, 0x0f, 0x9f, 0x4d, 0x32, 0x97, 0xda, 0x98,
-	0x1d, 0xf4, 0xad, 0x9c, 0x4d, 0xba, 0x79, 0x9b, 0x74, 0x11, 0xb3, 0x5a, 0x6c, 0xb1, 0xe8, 0x60,
-	0x8b, 0xe6, 0xad, 0x21, 0x43, 0x34, 0xb7, 0x8b, 0x06, 0x25, 0x7f, 0xa1, 0xfb, 0x04, 0xfe, 0x7d,
-	0x16, 0x9f, 0x8e, 0x30, 0x1f, 0x0e, 0x62, 0xb3, 0xf3, 0xb7, 0x04, 0xc0, 0x62, 0x42, 0xc1, 0x2b,
-	0xe0, 0x74, 0xb1, 0x5c, 0x56, 0x1b, 0x0d, 0xa3, 0xb9, 0x5f, 0x57, 0x8d, 0x5b, 0xd5, 0x46, 0x5d,
-	0x2d, 0x6b, 0xd7, 0x35, 0xb5, 0x22, 0x87, 0x52, 0x9b, 0xa3, 0x71, 0xf6, 0xd4, 0x22, 0xf8, 0x96,
-	0x4b, 0x7b, 0xc8, 0xc6, 0x2d, 0x8c, 0x1c, 0x78, 0x01, 0xc0, 0x20, 0xae, 0x5a, 0x2b, 0xd5, 0x2a,
-	0xfb, 0xb2, 0x94, 0xda, 0x18, 0x8d, 0xb3, 0xf2, 0x02, 0x52, 0x25, 0x16, 0x71, 0x86, 0xb0, 0x00,
-	0x4e, 0x05, 0xa3, 0xd5, 0x8f, 0x55, 0x7d, 0x9f, 0x03, 0x22, 0xa9, 0xd3, 0xa3, 0x71, 0xf6, 0xad,
-	0x05, 0x40, 0x3d, 0x44, 0xde, 0x90, 0x63, 0xae, 0x81, 0xad, 0x20, 0xa6, 0x58, 0xdd, 0x37, 0x6a,
-	0xd7, 0x8d, 0x62, 0xa5, 0xa2, 0xab, 0x8d, 0x86, 0xda, 0x90, 0xa3, 0xa9, 0xad, 0xd1, 0x38, 0x9b,
-	0x5c, 0x40, 0x8b, 0xee, 0xb0, 0xd6, 0x2a, 0xce, 0xbf, 0x27, 0xa9, 0xd8, 0x17, 0x3f, 0xa4, 0x43,
-	0xf7, 0x7f, 0x4c, 0x87, 0x14, 0xff, 0x9b, 0x12, 0xde, 0xf9, 0x29, 0x02, 0xb2, 0xc7, 0x5d, 0x41,
-	0x88, 0xc0, 0xa5, 0x72, 0xad, 0xda, 0xd4, 0x8b, 0xe5, 0xa6, 0x51, 0xae, 0x55, 0x54, 0x63, 0x57,
-	0x6b, 0x34, 0x6b, 0xfa, 0xbe, 0x51, 0xab, 0xab, 0x7a, 0xb1, 0xa9, 0xd5, 0xaa, 0x2f, 0xd3, 0x29,
-	0x3f, 0x1a, 0x67, 0xcf, 0x1f, 0xc7, 0x1d, 0x54, 0xef, 0x36, 0x38, 0xb7, 0xd4, 0x31, 0x5a, 0x55,
-	0x6b, 0xca, 0x52, 0xea, 0xec, 0x68, 0x9c, 0x3d, 0x73, 0x1c, 0xbf, 0xe6, 0x62, 0x06, 0xef, 0x80,
-	0x0b, 0x4b, 0x11, 0xef, 0x69, 0x37, 0xf4, 0x62, 0x53, 0x95, 0xc3, 0xa9, 0xf3, 0xa3, 0x71, 0xf6,
-	0xfd, 0xe3, 0xb8, 0xf7, 0x70, 0xdb, 0x33, 0x19, 0x5a, 0x9a, 0xfe, 0x86, 0x5a, 0x55, 0x1b, 0x5a,
-	0x43, 0x8e, 0x2c, 0x47, 0x7f, 0x03, 0xb9, 0x88, 0x62, 0x9a, 0x8a, 0xfa, 0x2d, 0x2b, 0xed, 0x3e,
-	0xfa, 0x33, 0x1d, 0xba, 0x3f, 0x4d, 0x4b, 0x8f, 0xa6, 0x69, 0xe9, 0xf1, 0x34, 0x2d, 0xfd, 0x31,
-	0x4d, 0x4b, 0x5f, 0x3f, 0x49, 0x87, 0x1e, 0x3f, 0x49, 0x87, 0x7e, 0x7f, 0x92, 0x0e, 0x7d, 0xba,
-	0x1d, 0x78, 0x21, 0xca, 0x84, 0x76, 0x6f, 0xcf, 0xff, 0xe0, 0x9c, 0xfc, 0x40, 0xfc, 0xc9, 0xf1,
-	0xdf, 0x38, 0x6b, 0x95, 0xcf, 0xbf, 0x0f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x13, 0xdb, 0xf4,
-	0x60, 0xe7, 0x09, 0x00, 0x00,
+	// 1250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xda, 0x4e, 0x62, 0x4f, 0x4c, 0x71, 0x86, 0x54, 0x75, 0x4c, 0xb0, 0xcd, 0x52, 0x42,
+	0x9a, 0xb6, 0x76, 0x6b, 0x50, 0x85, 0x7a, 0xa8, 0xe4, 0x8f, 0x6d, 0xb3, 0x15, 0xb1, 0xad, 0xb5,
+	0x4b, 0x09, 0x52, 0x59, 0xad, 0x77, 0xc7, 0xce, 0xd0, 0xf5, 0x8e, 0xb5, 0x33, 0x4e, 0xed, 0x7f,
+	0x80, 0x8c, 0x90, 0x38, 0x22, 0x24, 0x4b, 0x48, 0x20, 0xe8, 0xb1, 0x87, 0xfe, 0x01, 0x6e, 0x15,
+	0xa7, 0x8a, 0x13, 0x27, 0x0b, 0xdc, 0x43, 0x39, 0xe7, 0xc0, 0xa1, 0x27, 0xb4, 0x33, 0x36, 0x5e,
+	0xfa, 0x91, 0x18, 0x2e, 0xab, 0x99, 0xf7, 0x7d, 0x9e, 0x67, 0xde, 0x8f, 0xd9, 0x77, 0x17, 0x6c,
+	0x9a, 0x84, 0x76, 0xee, 0x19, 0xb4, 0x93, 0xe3, 0x8f, 0xc3, 0xcb, 0x39, 0x36, 0xe8, 0x22, 0x9a,
+	0xed, 0xba, 0x84, 0x11, 0x18, 0x9f, 0x79, 0xb3, 0xfc, 0x71, 0x78, 0x39, 0xb9, 0xe1, 0x59, 0x08,
+	0xd5, 0xb9, 0x3f, 0x27, 0x36, 0x02, 0x9c, 0x5c, 0x6f, 0x93, 0x36, 0x11, 0x76, 0x6f, 0x35, 0xb5,
+	0x6e, 0xb4, 0x09, 0x69, 0xdb, 0x28, 0xc7, 0x77, 0xcd, 0x5e, 0x2b, 0x67, 0x38, 0x83, 0xa9, 0x6b,
+	0xcd, 0xe8, 0x60, 0x87, 0xe4, 0xf8, 0x53, 0x98, 0xe4, 0x3b, 0xe0, 0xf5, 0x82, 0x69, 0x22, 0x4a,
+	0x1b, 0x83, 0x2e, 0xaa, 0x19, 0xae, 0xd1, 0x81, 0x65, 0xb0, 0x74, 0x68, 0xd8, 0x3d, 0x94, 0x90,
+	0x32, 0xd2, 0xf6, 0xa9, 0xfc, 0x66, 0xf6, 0xf9, 0x98, 0xb2, 0x73, 0x46, 0x31, 0x7e, 0x34, 0x4e,
+	0xc7, 0x06, 0x46, 0xc7, 0xbe, 0x2a, 0x73, 0x92, 0xac, 0x09, 0xf2, 0xd5, 0xf0, 0x37, 0xdf, 0xa5,
+	0x25, 0xf9, 0x27, 0x09, 0xc4, 0x04, 0xba, 0x44, 0x9c, 0x16, 0x6e, 0xc3, 0x3a, 0x00, 0x5d, 0xe4,
+	0x76, 0x30, 0xa5, 0x98, 0x38, 0x0b, 0x9d, 0x70, 0xfa, 0x68, 0x9c, 0x5e, 0x13, 0x27, 0xcc, 0x99,
+	0xb2, 0xe6, 0x93, 0x81, 0x57, 0x40, 0xd4, 0xb0, 0x2c, 0x17, 0x51, 0x8a, 0x68, 0x22, 0x94, 0x09,
+	0x6d, 0x47, 0x8b, 0x89, 0x5f, 0x1f, 0x5e, 0x5c, 0x9f, 0x56, 0xab, 0x20, 0x7c, 0x75, 0xe6, 0x62,
+	0xa7, 0xad, 0xcd, 0xa1, 0x22, 0xc6, 0x9b, 0xe1, 0x48, 0x30, 0x1e, 0x92, 0x27, 0x41, 0xb0, 0xcc,
+	0xf3, 0xa7, 0x90, 0x01, 0x68, 0x12, 0x0b, 0xe9, 0xbd, 0xae, 0x4d, 0x0c, 0x4b, 0x37, 0x78, 0x2c,
+	0x3c, 0xd6, 0xd5, 0x7c, 0xea, 0x55, 0xb1, 0x8a, 0xfc, 0x8a, 0x5b, 0x8f, 0xc6, 0xe9, 0xc0, 0xd1,
+	0x38, 0xbd, 0x21, 0x22, 0x7e, 0x51, 0x47, 0xbe, 0xff, 0xf4, 0xc1, 0x8e, 0xa4, 0xc5, 0x3d, 0xcf,
+	0x2d, 0xee, 0x10, 0x7c, 0xf8, 0x95, 0x04, 0x52, 0xd8, 0xa1, 0xcc, 0x70, 0x18, 0x36, 0x18, 0xd2,
+	0x2d, 0xd4, 0x32, 0x7a, 0x36, 0xd3, 0x7d, 0xe5, 0x0a, 0x2e, 0x50, 0xae, 0x73, 0x47, 0xe3, 0xf4,
+	0xbb, 0xe2, 0xf0, 0xe3, 0xd5, 0x64, 0x6d, 0xd3, 0x07, 0x28, 0x0b, 0x7f, 0x6d, 0x5e, 0xd4, 0x8f,
+	0xa6, 0x55, 0xb0, 0x90, 0xd5, 0xeb, 0xda, 0xd8, 0x34, 0x98, 0x17, 0x42, 0x28, 0x23, 0x6d, 0x47,
+	0x8a, 0x6f, 0x3d, 0x97, 0xe1, 0xbf, 0x30, 0xb2, 0xb6, 0xe6, 0x19, 0xcb, 0x7e, 0x1b, 0x2f, 0x75,
+	0x40, 0xfe, 0x59, 0x02, 0x91, 0x12, 0xb1, 0x90, 0xea, 0xb4, 0x08, 0x7c, 0x13, 0x44, 0x39, 0xf9,
+	0xc0, 0xa0, 0x07, 0xbc, 0xba, 0x31, 0x2d, 0xe2, 0x19, 0x76, 0x0d, 0x7a, 0x00, 0xf3, 0x60, 0xc5,
+	0x74, 0x91, 0xc1, 0x88, 0xcb, 0xb3, 0x3e, 0xae, 0xa1, 0x33, 0x20, 0xfc, 0x04, 0x40, 0x7f, 0xca,
+	0x26, 0xef, 0x48, 0x62, 0x69, 0xa1, 0xbe, 0x45, 0xbd, 0xbe, 0x89, 0xd6, 0xac, 0xf9, 0x44, 0x84,
+	0xf7, 0x66, 0x38, 0x12, 0x8a, 0x87, 0x6f, 0x86, 0x23, 0xe1, 0xf8, 0x92, 0xfc, 0x30, 0x04, 0x62,
+	0x25, 0xe2, 0x30, 0xd7, 0x30, 0x19, 0xcf, 0xe3, 0x1d, 0xb0, 0xc2, 0xf3, 0xc0, 0x16, 0xcf, 0x22,
+	0x5c, 0x04, 0x93, 0x71, 0x7a, 0x99, 0xa7, 0x59, 0xd6, 0x96, 0x3d, 0x97, 0x6a, 0xfd, 0xaf, 0x7c,
+	0xb2, 0x60, 0xc9, 0xb0, 0x3a, 0x58, 0x14, 0xfd, 0x38, 0x86, 0x80, 0xc1, 0x75, 0xb0, 0x64, 0x1b,
+	0x4d, 0x64, 0x27, 0xc2, 0x1e, 0x5e, 0x13, 0x1b, 0x78, 0x6d, 0x7a, 0x32, 0xb2, 0xa6, 0xa5, 0x38,
+	0xfb, 0x92, 0x52, 0x34, 0x29, 0xb1, 0x7b, 0x0c, 0x35, 0xfa, 0x35, 0x42, 0xb1, 0xd7, 0x30, 0x6d,
+	0x46, 0x82, 0x17, 0xc1, 0x2a, 0x6e, 0x9a, 0x7a, 0x97, 0xb8, 0xcc, 0x4b, 0x71, 0x99, 0xc7, 0xf2,
+	0xda, 0x64, 0x9c, 0x8e, 0xaa, 0xc5, 0x52, 0x8d, 0xb8, 0x4c, 0x2d, 0x6b, 0x51, 0xdc, 0x34, 0xf9,
+	0xd2, 0x82, 0x97, 0x40, 0x0c, 0x37, 0xcd, 0xfc, 0x3f, 0xf8, 0x15, 0x8e, 0x3f, 0x35, 0x19, 0xa7,
+	0x81, 0x5a, 0x2c, 0xe5, 0xa7, 0x04, 0xe0, 0x61, 0xa6, 0x8c, 0xcf, 0x40, 0x14, 0xf5, 0x19, 0x72,
+	0xf8, 0x15, 0x8f, 0xf0, 0x10, 0xd7, 0xb3, 0x62, 0x88, 0x65, 0x67, 0x43, 0x2c, 0x5b, 0x70, 0x06,
+	0xc5, 0x9d, 0x5f, 0x1e, 0x5e, 0xdc, 0x7a, 0x21, 0x76, 0x7f, 0x2f, 0x94, 0x99, 0x8e, 0x36, 0x97,
+	0xbc, 0x1a, 0xfe, 0xd3, 0x9b, 0x44, 0x5f, 0x06, 0x41, 0x62, 0x06, 0xf5, 0x7a, 0xb3, 0x8b, 0x29,
+	0x23, 0xee, 0x40, 0x71, 0x98, 0x3b, 0x80, 0x35, 0x10, 0x25, 0x5d, 0xe4, 0x8a, 0x2b, 0x2e, 0x86,
+	0x52, 0x3e, 0xfb, 0xca, 0x93, 0x7c, 0xf4, 0xea, 0x8c, 0xe5, 0xbd, 0x7b, 0xda, 0x5c, 0xc4, 0x7f,
+	0x29, 0x82, 0xaf, 0xbc, 0x14, 0xd7, 0xc0, 0x4a, 0xaf, 0x6b, 0xf1, 0xd6, 0x84, 0xfe, 0x4b, 0x6b,
+	0xa6, 0x24, 0xf8, 0x21, 0x08, 0x75, 0x68, 0x9b, 0xb7, 0x3b, 0x56, 0xdc, 0x7a, 0x36, 0x4e, 0x43,
+	0xcd, 0xb8, 0x37, 0x8b, 0x72, 0x0f, 0x51, 0x6a, 0xb4, 0xd1, 0xb7, 0x4f, 0x1f, 0xec, 0xac, 0x62,
+	0xc7, 0xc6, 0x0e, 0xd2, 0x3f, 0xa7, 0xc4, 0xd1, 0x3c, 0x8a, 0xac, 0x01, 0xf8, 0xa2, 0x30, 0x7c,
+	0x1b, 0xc4, 0x9a, 0x36, 0x31, 0xef, 0xea, 0x07, 0x08, 0xb7, 0x0f, 0x98, 0xb8, 0xce, 0xda, 0x2a,
+	0xb7, 0xed, 0x72, 0x13, 0xdc, 0x00, 0x11, 0xd6, 0xd7, 0xb1, 0x63, 0xa1, 0xbe, 0x48, 0x4c, 0x5b,
+	0x61, 0x7d, 0xd5, 0xdb, 0xca, 0x08, 0x2c, 0xed, 0x11, 0x0b, 0xd9, 0xf0, 0x3a, 0x08, 0xdd, 0x45,
+	0x03, 0xf1, 0x4a, 0x17, 0x3f, 0x78, 0x36, 0x4e, 0x5f, 0x6a, 0x63, 0x76, 0xd0, 0x6b, 0x66, 0x4d,
+	0xd2, 0xc9, 0x99, 0xa4, 0x83, 0x58, 0xb3, 0xc5, 0xe6, 0x0b, 0x1b, 0x37, 0x69, 0xae, 0x39, 0x60,
+	0x88, 0x66, 0x77, 0x51, 0xbf, 0xe8, 0x2d, 0x34, 0x4f, 0xc0, 0xbb, 0xcf, 0xe2, 0x43, 0x14, 0xe4,
+	0xc3, 0x41, 0x6c, 0x76, 0xfe, 0x92, 0x00, 0x98, 0xcf, 0x3b, 0x78, 0x05, 0x9c, 0x29, 0x94, 0x4a,
+	0x4a, 0xbd, 0xae, 0x37, 0xf6, 0x6b, 0x8a, 0x7e, 0xab, 0x52, 0xaf, 0x29, 0x25, 0xf5, 0xba, 0xaa,
+	0x94, 0xe3, 0x81, 0xe4, 0xc6, 0x70, 0x94, 0x39, 0x3d, 0x07, 0xdf, 0x72, 0x68, 0x17, 0x99, 0xb8,
+	0x85, 0x91, 0x05, 0x2f, 0x00, 0xe8, 0xe7, 0x55, 0xaa, 0xc5, 0x6a, 0x79, 0x3f, 0x2e, 0x25, 0xd7,
+	0x87, 0xa3, 0x4c, 0x7c, 0x4e, 0xa9, 0x90, 0x26, 0xb1, 0x06, 0x30, 0x0f, 0x4e, 0xfb, 0xd1, 0xca,
+	0xc7, 0x8a, 0xb6, 0xcf, 0x09, 0xa1, 0xe4, 0x99, 0xe1, 0x28, 0xf3, 0xc6, 0x9c, 0xa0, 0x1c, 0x22,
+	0x77, 0xc0, 0x39, 0xd7, 0xc0, 0xa6, 0x9f, 0x53, 0xa8, 0xec, 0xeb, 0xd5, 0xeb, 0x7a, 0xa1, 0x5c,
+	0xd6, 0x94, 0x7a, 0x5d, 0xa9, 0xc7, 0xc3, 0xc9, 0xcd, 0xe1, 0x28, 0x93, 0x98, 0x53, 0x0b, 0xce,
+	0xa0, 0xda, 0x2a, 0xcc, 0xbe, 0x4e, 0xc9, 0xc8, 0x17, 0xdf, 0xa7, 0x02, 0xf7, 0x7f, 0x48, 0x05,
+	0x64, 0xef, 0x0b, 0x15, 0xdc, 0xf9, 0x31, 0x04, 0x32, 0x27, 0x5d, 0x41, 0x88, 0xc0, 0xa5, 0x52,
+	0xb5, 0xd2, 0xd0, 0x0a, 0xa5, 0x86, 0x5e, 0xaa, 0x96, 0x15, 0x7d, 0x57, 0xad, 0x37, 0xaa, 0xda,
+	0xbe, 0x5e, 0xad, 0x29, 0x5a, 0xa1, 0xa1, 0x56, 0x2b, 0x2f, 0xab, 0x53, 0x6e, 0x38, 0xca, 0x9c,
+	0x3f, 0x49, 0xdb, 0x5f, 0xbd, 0xdb, 0xe0, 0xdc, 0x42, 0xc7, 0xa8, 0x15, 0xb5, 0x11, 0x97, 0x92,
+	0xdb, 0xc3, 0x51, 0xe6, 0xec, 0x49, 0xfa, 0xaa, 0x83, 0x19, 0xbc, 0x03, 0x2e, 0x2c, 0x24, 0xbc,
+	0xa7, 0xde, 0xd0, 0x0a, 0x0d, 0x25, 0x1e, 0x4c, 0x9e, 0x1f, 0x8e, 0x32, 0xef, 0x9d, 0xa4, 0xbd,
+	0x87, 0xdb, 0xae, 0xc1, 0xd0, 0xc2, 0xf2, 0x37, 0x94, 0x8a, 0x52, 0x57, 0xeb, 0xf1, 0xd0, 0x62,
+	0xf2, 0x37, 0x90, 0x83, 0x28, 0xa6, 0xc9, 0xb0, 0xd7, 0xb2, 0xe2, 0xee, 0xa3, 0x3f, 0x52, 0x81,
+	0xfb, 0x93, 0x94, 0xf4, 0x68, 0x92, 0x92, 0x1e, 0x4f, 0x52, 0xd2, 0xef, 0x93, 0x94, 0xf4, 0xf5,
+	0x93, 0x54, 0xe0, 0xf1, 0x93, 0x54, 0xe0, 0xb7, 0x27, 0xa9, 0xc0, 0xa7, 0x5b, 0xbe, 0x17, 0xa2,
+	0x44, 0x68, 0xe7, 0xf6, 0xec, 0x7f, 0xd0, 0xca, 0xf5, 0xc5, 0x7f, 0x21, 0xff, 0x29, 0x6c, 0x2e,
+	0xf3, 0xf9, 0xf7, 0xfe, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x20, 0x45, 0xb6, 0x35, 0x0a,
+	0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -655,6 +662,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
 		return false
 	}
+	if this.CodeDeduplication != that1.CodeDeduplication {
+		return false
+	}
 	return true
 }
 
@@ -910,6 +920,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CodeDeduplication {
+		i--
+		if m.CodeDeduplication {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.InstantiateDefaultPermission != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
 		i--
@@ -1237,6 +1257,9 @@ func (m *Params) Size() (n int) {
 	if m.InstantiateDefaultPermission != 0 {
 		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
 	}
+	if m.CodeDeduplication {
+		n += 2
+	}
 	return n
 }
 
@@ -1615,6 +1638,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDeduplication", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CodeDeduplication = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
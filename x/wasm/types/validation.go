@@ -30,6 +30,9 @@ var (
 
 	// MaxAddressCount is the maximum number of addresses allowed within a message
 	MaxAddressCount = 50
+
+	// MaxCodeMetadataSize is the largest metadata blob that can be attached to a code
+	MaxCodeMetadataSize = 4 * 1024 // extension point for chains to customize via compile flag.
 )
 
 func validateWasmCode(s []byte, maxSize int) error {
@@ -12,6 +12,9 @@ const DefaultMaxQueryStackSize uint32 = 10
 
 const DefaultMaxCallDepth uint32 = 500
 
+// DefaultMaxContractMessages maximum number of messages a contract can return in a single response
+const DefaultMaxContractMessages uint32 = 1000
+
 // WasmEngine defines the WASM contract runtime engine.
 type WasmEngine interface {
 	// StoreCode will compile the Wasm code, and store the resulting compiled module